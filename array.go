@@ -0,0 +1,367 @@
+package generic
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// ErrInvalidArrayLiteral is returned when a PostgreSQL array literal cannot be parsed
+var ErrInvalidArrayLiteral = errors.New("invalid array literal")
+
+// arrayElem is the interface implemented by pointers to the element types of arrays
+type arrayElem interface {
+	Type
+	json.Marshaler
+	json.Unmarshaler
+}
+
+// arrayAlloc allocates n elements and returns an accessor for the i-th element.
+type arrayAlloc func(n int) func(i int) arrayElem
+
+// scanArray converts a specified value to array elements.
+// x may be a PostgreSQL array literal as string or []byte, or any slice whose elements can be scanned one by one.
+func scanArray(x interface{}, alloc arrayAlloc, parse func(e arrayElem, s string) error) (dims []int, isValid ValidFlag, err error) {
+	switch t := x.(type) {
+	case nil:
+		return nil, false, nil
+	case string:
+		return scanArrayLiteral(t, alloc, parse)
+	case []byte:
+		return scanArrayLiteral(string(t), alloc, parse)
+	}
+	rv := reflect.ValueOf(x)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false, ErrInvalidGenericValue{Value: x}
+	}
+	n := rv.Len()
+	at := alloc(n)
+	for i := 0; i < n; i++ {
+		if err = at(i).Scan(rv.Index(i).Interface()); err != nil {
+			return nil, false, err
+		}
+	}
+	if n > 0 {
+		dims = []int{n}
+	}
+	return dims, true, nil
+}
+
+func scanArrayLiteral(s string, alloc arrayAlloc, parse func(e arrayElem, s string) error) (dims []int, isValid ValidFlag, err error) {
+	elems, dims, err := parseArrayLiteral(s)
+	if err != nil {
+		return nil, false, err
+	}
+	at := alloc(len(elems))
+	for i, e := range elems {
+		if e == nil {
+			continue
+		}
+		if err = parse(at(i), *e); err != nil {
+			return nil, false, err
+		}
+	}
+	return dims, true, nil
+}
+
+// parseArrayLiteral parses a PostgreSQL array literal such as `{{1,NULL},{"a b",3}}`.
+// It returns the elements in row-major order, with nil for NULL, and the length of each dimension.
+func parseArrayLiteral(s string) (elems []*string, dims []int, err error) {
+	p := arrayParser{s: s, leaf: -1}
+	if strings.HasPrefix(p.s, "[") {
+		// skip explicit bounds such as "[0:1]="
+		i := strings.IndexByte(p.s, '=')
+		if i < 0 {
+			return nil, nil, ErrInvalidArrayLiteral
+		}
+		p.pos = i + 1
+	}
+	p.skipSpace()
+	if err = p.parse(0); err != nil {
+		return nil, nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.s) {
+		return nil, nil, ErrInvalidArrayLiteral
+	}
+	return p.elems, p.dims, nil
+}
+
+type arrayParser struct {
+	s     string
+	pos   int
+	elems []*string
+	dims  []int
+	leaf  int
+}
+
+func (p *arrayParser) skipSpace() {
+	for p.pos < len(p.s) && isArraySpace(p.s[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *arrayParser) parse(depth int) error {
+	if p.pos >= len(p.s) || p.s[p.pos] != '{' {
+		return ErrInvalidArrayLiteral
+	}
+	p.pos++
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == '}' {
+		p.pos++
+		// only the outermost array may be empty
+		if depth != 0 {
+			return ErrInvalidArrayLiteral
+		}
+		return nil
+	}
+	if len(p.dims) == depth {
+		// the length is unknown until the closing brace
+		p.dims = append(p.dims, -1)
+	}
+	count := 0
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.s) {
+			return ErrInvalidArrayLiteral
+		}
+		if p.s[p.pos] == '{' {
+			if err := p.parse(depth + 1); err != nil {
+				return err
+			}
+		} else {
+			if p.leaf < 0 {
+				p.leaf = depth
+			} else if p.leaf != depth {
+				return ErrInvalidArrayLiteral
+			}
+			e, err := p.element()
+			if err != nil {
+				return err
+			}
+			p.elems = append(p.elems, e)
+		}
+		count++
+		p.skipSpace()
+		if p.pos >= len(p.s) {
+			return ErrInvalidArrayLiteral
+		}
+		c := p.s[p.pos]
+		p.pos++
+		if c == '}' {
+			break
+		}
+		if c != ',' {
+			return ErrInvalidArrayLiteral
+		}
+	}
+	switch p.dims[depth] {
+	case -1:
+		p.dims[depth] = count
+	case count:
+	default:
+		return ErrInvalidArrayLiteral
+	}
+	return nil
+}
+
+func (p *arrayParser) element() (*string, error) {
+	buf := bytes.Buffer{}
+	if p.s[p.pos] == '"' {
+		p.pos++
+		for {
+			if p.pos >= len(p.s) {
+				return nil, ErrInvalidArrayLiteral
+			}
+			c := p.s[p.pos]
+			p.pos++
+			if c == '"' {
+				break
+			}
+			if c == '\\' {
+				if p.pos >= len(p.s) {
+					return nil, ErrInvalidArrayLiteral
+				}
+				c = p.s[p.pos]
+				p.pos++
+			}
+			buf.WriteByte(c)
+		}
+		s := buf.String()
+		return &s, nil
+	}
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if c == ',' || c == '}' {
+			break
+		}
+		if c == '{' || c == '"' {
+			return nil, ErrInvalidArrayLiteral
+		}
+		p.pos++
+		if c == '\\' {
+			if p.pos >= len(p.s) {
+				return nil, ErrInvalidArrayLiteral
+			}
+			c = p.s[p.pos]
+			p.pos++
+		}
+		buf.WriteByte(c)
+	}
+	s := strings.TrimRightFunc(buf.String(), func(r rune) bool {
+		return r < 0x80 && isArraySpace(byte(r))
+	})
+	if s == "" {
+		return nil, ErrInvalidArrayLiteral
+	}
+	if strings.EqualFold(s, "NULL") {
+		return nil, nil
+	}
+	return &s, nil
+}
+
+func isArraySpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+// formatArrayLiteral formats n elements in row-major order as a PostgreSQL array literal.
+// elem returns the text of the i-th element and false if it is NULL.
+func formatArrayLiteral(dims []int, n int, elem func(i int) (string, bool)) string {
+	if n == 0 || len(dims) == 0 {
+		return "{}"
+	}
+	buf := bytes.Buffer{}
+	i := 0
+	var write func(depth int)
+	write = func(depth int) {
+		buf.WriteByte('{')
+		for j := 0; j < dims[depth]; j++ {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			if depth < len(dims)-1 {
+				write(depth + 1)
+				continue
+			}
+			s, ok := elem(i)
+			i++
+			if !ok {
+				buf.WriteString("NULL")
+				continue
+			}
+			writeArrayElement(&buf, s)
+		}
+		buf.WriteByte('}')
+	}
+	write(0)
+	return buf.String()
+}
+
+func writeArrayElement(buf *bytes.Buffer, s string) {
+	if s != "" && !strings.EqualFold(s, "NULL") && !strings.ContainsAny(s, "{},\"\\ \t\n\r\v\f") {
+		buf.WriteString(s)
+		return
+	}
+	buf.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			buf.WriteByte('\\')
+		}
+		buf.WriteByte(s[i])
+	}
+	buf.WriteByte('"')
+}
+
+// marshalArrayJSON encodes n elements in row-major order as nested JSON arrays.
+func marshalArrayJSON(dims []int, n int, elem func(i int) ([]byte, error)) ([]byte, error) {
+	if n == 0 || len(dims) == 0 {
+		return []byte("[]"), nil
+	}
+	buf := bytes.Buffer{}
+	i := 0
+	var write func(depth int) error
+	write = func(depth int) error {
+		buf.WriteByte('[')
+		for j := 0; j < dims[depth]; j++ {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			if depth < len(dims)-1 {
+				if err := write(depth + 1); err != nil {
+					return err
+				}
+				continue
+			}
+			b, err := elem(i)
+			if err != nil {
+				return err
+			}
+			i++
+			buf.Write(b)
+		}
+		buf.WriteByte(']')
+		return nil
+	}
+	if err := write(0); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// unmarshalArrayJSON decodes nested JSON arrays, applying the element type's own JSON decoding to each element.
+func unmarshalArrayJSON(data []byte, alloc arrayAlloc) (dims []int, isValid ValidFlag, err error) {
+	if len(data) == 0 || bytes.Equal(bytes.TrimSpace(data), nullBytes) {
+		return nil, false, nil
+	}
+	var raws []json.RawMessage
+	leaf := -1
+	var walk func(data []byte, depth int) error
+	walk = func(data []byte, depth int) error {
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		if len(items) == 0 && depth != 0 {
+			return ErrInvalidGenericValue{Value: string(data)}
+		}
+		switch {
+		case len(dims) == depth:
+			dims = append(dims, len(items))
+		case dims[depth] != len(items):
+			return ErrInvalidGenericValue{Value: string(data)}
+		}
+		for _, item := range items {
+			if t := bytes.TrimSpace(item); len(t) > 0 && t[0] == '[' {
+				if err := walk(t, depth+1); err != nil {
+					return err
+				}
+				continue
+			}
+			if leaf < 0 {
+				leaf = depth
+			} else if leaf != depth {
+				return ErrInvalidGenericValue{Value: string(data)}
+			}
+			raws = append(raws, item)
+		}
+		return nil
+	}
+	if err = walk(data, 0); err != nil {
+		return nil, false, err
+	}
+	if len(raws) == 0 {
+		dims = nil
+	}
+	at := alloc(len(raws))
+	for i, raw := range raws {
+		if bytes.Equal(bytes.TrimSpace(raw), nullBytes) {
+			continue
+		}
+		if err = at(i).UnmarshalJSON(raw); err != nil {
+			return nil, false, err
+		}
+	}
+	return dims, true, nil
+}
@@ -0,0 +1,72 @@
+package generic
+
+import (
+	"reflect"
+	"testing"
+)
+
+func strp(s string) *string {
+	return &s
+}
+
+func Test_parseArrayLiteral(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      string
+		wantElems []*string
+		wantDims  []int
+		wantErr   bool
+	}{
+		{name: "empty", args: "{}", wantElems: nil, wantDims: nil},
+		{name: "one dimension", args: "{1,2,3}", wantElems: []*string{strp("1"), strp("2"), strp("3")}, wantDims: []int{3}},
+		{name: "null", args: "{1,NULL,null}", wantElems: []*string{strp("1"), nil, nil}, wantDims: []int{3}},
+		{name: "quoted null", args: `{"NULL"}`, wantElems: []*string{strp("NULL")}, wantDims: []int{1}},
+		{name: "quoted", args: `{"a b","c,d","e\"f","g\\h",""}`, wantElems: []*string{strp("a b"), strp("c,d"), strp(`e"f`), strp(`g\h`), strp("")}, wantDims: []int{5}},
+		{name: "spaces", args: " { a , b } ", wantElems: []*string{strp("a"), strp("b")}, wantDims: []int{2}},
+		{name: "two dimensions", args: "{{1,2},{3,NULL}}", wantElems: []*string{strp("1"), strp("2"), strp("3"), nil}, wantDims: []int{2, 2}},
+		{name: "bounds", args: "[0:1]={1,2}", wantElems: []*string{strp("1"), strp("2")}, wantDims: []int{2}},
+		{name: "ragged", args: "{{1,2},{3}}", wantErr: true},
+		{name: "mixed depth", args: "{1,{2}}", wantErr: true},
+		{name: "unterminated", args: "{1,2", wantErr: true},
+		{name: "unterminated quote", args: `{"1}`, wantErr: true},
+		{name: "trailing", args: "{1}x", wantErr: true},
+		{name: "empty element", args: "{1,,2}", wantErr: true},
+		{name: "not array", args: "1,2", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotElems, gotDims, err := parseArrayLiteral(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseArrayLiteral() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(gotElems, tt.wantElems) {
+				t.Errorf("parseArrayLiteral() elems = %v, want %v", gotElems, tt.wantElems)
+			}
+			if !reflect.DeepEqual(gotDims, tt.wantDims) {
+				t.Errorf("parseArrayLiteral() dims = %v, want %v", gotDims, tt.wantDims)
+			}
+		})
+	}
+}
+
+func Test_formatArrayLiteral(t *testing.T) {
+	elems := []*string{strp("a"), nil, strp("b c"), strp(`"`), strp(""), strp("null")}
+	elem := func(i int) (string, bool) {
+		if elems[i] == nil {
+			return "", false
+		}
+		return *elems[i], true
+	}
+	if got := formatArrayLiteral([]int{6}, 6, elem); got != `{a,NULL,"b c","\"","","null"}` {
+		t.Errorf("formatArrayLiteral() = %s", got)
+	}
+	if got := formatArrayLiteral([]int{2, 3}, 6, elem); got != `{{a,NULL,"b c"},{"\"","","null"}}` {
+		t.Errorf("formatArrayLiteral() = %s", got)
+	}
+	if got := formatArrayLiteral(nil, 0, elem); got != "{}" {
+		t.Errorf("formatArrayLiteral() = %s", got)
+	}
+}
@@ -0,0 +1,114 @@
+package generic
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrTimeOutOfRange is returned when a time is outside the range allowed relative to the clock
+var ErrTimeOutOfRange = errors.New("time out of range")
+
+// Clock tells the current time to the time types and helpers
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// DefaultClock is used by Now, the XxxNow helpers, NewUUIDv7 and the relative methods of the time types.
+// Replace it with a FakeClock in tests.
+var DefaultClock Clock = systemClock{}
+
+// FakeClock is a Clock whose time only changes when it is set or advanced.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock returns a FakeClock stopped at t.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t}
+}
+
+// Now implements the Clock interface.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set sets the time of the clock to t.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// Advance moves the clock forward by d.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Now returns generic.Time of the current time of DefaultClock.
+func Now() Time {
+	return Time{ValidFlag: true, time: DefaultClock.Now()}
+}
+
+// TimestampNow returns generic.Timestamp of the current time of DefaultClock.
+func TimestampNow() Timestamp {
+	return Timestamp{ValidFlag: true, time: DefaultClock.Now()}
+}
+
+// TimestampMSNow returns generic.TimestampMS of the current time of DefaultClock.
+func TimestampMSNow() TimestampMS {
+	return TimestampMS{ValidFlag: true, time: DefaultClock.Now()}
+}
+
+// TimestampMicroNow returns generic.TimestampMicro of the current time of DefaultClock.
+func TimestampMicroNow() TimestampMicro {
+	return TimestampMicro{ValidFlag: true, time: DefaultClock.Now()}
+}
+
+// TimestampNanoNow returns generic.TimestampNano of the current time of DefaultClock.
+func TimestampNanoNow() TimestampNano {
+	return TimestampNano{ValidFlag: true, time: DefaultClock.Now()}
+}
+
+// sinceClock returns the time elapsed since t by DefaultClock, or 0 if t is not valid.
+func sinceClock(t time.Time, valid ValidFlag) time.Duration {
+	if !valid {
+		return 0
+	}
+	return DefaultClock.Now().Sub(t)
+}
+
+// isPast reports whether t is valid and before the time of DefaultClock.
+func isPast(t time.Time, valid ValidFlag) bool {
+	return bool(valid) && t.Before(DefaultClock.Now())
+}
+
+// isFuture reports whether t is valid and after the time of DefaultClock.
+func isFuture(t time.Time, valid ValidFlag) bool {
+	return bool(valid) && t.After(DefaultClock.Now())
+}
+
+// validateWithin checks that t is no earlier than past before and no later than future after the time of DefaultClock.
+// It returns ErrInvalidGenericValue if t is not valid, so that a NULL never passes.
+func validateWithin(t time.Time, valid ValidFlag, past, future time.Duration) error {
+	if !valid {
+		return ErrInvalidGenericValue{Value: nil}
+	}
+	now := DefaultClock.Now()
+	if t.Before(now.Add(-past)) || t.After(now.Add(future)) {
+		return ErrTimeOutOfRange
+	}
+	return nil
+}
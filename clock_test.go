@@ -0,0 +1,99 @@
+package generic
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func useFakeClock(t *testing.T, now time.Time) *FakeClock {
+	t.Helper()
+	saved := DefaultClock
+	c := NewFakeClock(now)
+	DefaultClock = c
+	t.Cleanup(func() { DefaultClock = saved })
+	return c
+}
+
+func TestFakeClock(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	c := NewFakeClock(now)
+	if !c.Now().Equal(now) {
+		t.Errorf("FakeClock.Now() = %v, want %v", c.Now(), now)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Advance(time.Second)
+		}()
+	}
+	wg.Wait()
+	if want := now.Add(10 * time.Second); !c.Now().Equal(want) {
+		t.Errorf("FakeClock.Advance() = %v, want %v", c.Now(), want)
+	}
+	c.Set(now)
+	if !c.Now().Equal(now) {
+		t.Errorf("FakeClock.Set() = %v, want %v", c.Now(), now)
+	}
+}
+
+func TestNowHelpers(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	useFakeClock(t, now)
+	if v := Now(); !v.Valid() || !v.Time().Equal(now) {
+		t.Errorf("Now() = %v", v)
+	}
+	if v := TimestampNow(); v.Int64() != now.Unix() {
+		t.Errorf("TimestampNow() = %v", v)
+	}
+	if v := TimestampMSNow(); v.Int64() != now.UnixMilli() {
+		t.Errorf("TimestampMSNow() = %v", v)
+	}
+	if v := TimestampMicroNow(); v.Int64() != now.UnixMicro() {
+		t.Errorf("TimestampMicroNow() = %v", v)
+	}
+	if v := TimestampNanoNow(); v.Int64() != now.UnixNano() {
+		t.Errorf("TimestampNanoNow() = %v", v)
+	}
+	u, err := NewUUIDv7()
+	if err != nil {
+		t.Fatalf("NewUUIDv7() error = %v", err)
+	}
+	if b := u.Bytes(); int64(b[0])<<40|int64(b[1])<<32|int64(b[2])<<24|int64(b[3])<<16|int64(b[4])<<8|int64(b[5]) != now.UnixMilli() {
+		t.Errorf("NewUUIDv7() should take its timestamp from DefaultClock")
+	}
+}
+
+func TestTimeRelative(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	c := useFakeClock(t, now)
+	v := MustTime(now.Add(-time.Hour))
+	if v.Since() != time.Hour || !v.IsPast() || v.IsFuture() {
+		t.Errorf("Time.Since() = %v, IsPast() = %v, IsFuture() = %v", v.Since(), v.IsPast(), v.IsFuture())
+	}
+	if err := v.ValidateWithin(24*time.Hour, 0); err != nil {
+		t.Errorf("Time.ValidateWithin() error = %v", err)
+	}
+	c.Advance(24 * time.Hour)
+	if err := v.ValidateWithin(24*time.Hour, 0); err != ErrTimeOutOfRange {
+		t.Errorf("Time.ValidateWithin() error = %v, want ErrTimeOutOfRange", err)
+	}
+	f := MustTimestampMS(now.Add(48 * time.Hour))
+	if !f.IsFuture() || f.IsPast() || f.Since() != -24*time.Hour {
+		t.Errorf("TimestampMS.IsFuture() = %v, Since() = %v", f.IsFuture(), f.Since())
+	}
+	if err := f.ValidateWithin(0, time.Hour); err != ErrTimeOutOfRange {
+		t.Errorf("TimestampMS.ValidateWithin() error = %v, want ErrTimeOutOfRange", err)
+	}
+	var z ZonedTime
+	if z.Since() != 0 || z.IsPast() || z.IsFuture() {
+		t.Error("invalid ZonedTime should be neither past nor future")
+	}
+	var e ErrInvalidGenericValue
+	if err := z.ValidateWithin(24*time.Hour, 24*time.Hour); !errors.As(err, &e) {
+		t.Errorf("ZonedTime.ValidateWithin() of invalid value error = %v, want ErrInvalidGenericValue", err)
+	}
+}
@@ -0,0 +1,30 @@
+package generic
+
+// NullOrder decides where invalid values are placed when ordering generic types
+type NullOrder int
+
+const (
+	// NullsFirst orders invalid values before all valid values
+	NullsFirst NullOrder = iota
+	// NullsLast orders invalid values after all valid values
+	NullsLast
+)
+
+// compareValidity compares the validity of two values.
+// done is false only when both values are valid and the values themselves must be compared.
+func compareValidity(a, b bool, o NullOrder) (result int, done bool) {
+	switch {
+	case a && b:
+		return 0, false
+	case !a && !b:
+		return 0, true
+	case !a:
+		result = -1
+	default:
+		result = 1
+	}
+	if o == NullsLast {
+		result = -result
+	}
+	return result, true
+}
@@ -0,0 +1,29 @@
+package generic
+
+import "testing"
+
+func Test_compareValidity(t *testing.T) {
+	tests := []struct {
+		name       string
+		a          bool
+		b          bool
+		o          NullOrder
+		wantResult int
+		wantDone   bool
+	}{
+		{name: "both valid", a: true, b: true, o: NullsFirst, wantResult: 0, wantDone: false},
+		{name: "both invalid", a: false, b: false, o: NullsFirst, wantResult: 0, wantDone: true},
+		{name: "invalid first", a: false, b: true, o: NullsFirst, wantResult: -1, wantDone: true},
+		{name: "invalid last", a: false, b: true, o: NullsLast, wantResult: 1, wantDone: true},
+		{name: "valid first", a: true, b: false, o: NullsFirst, wantResult: 1, wantDone: true},
+		{name: "valid last", a: true, b: false, o: NullsLast, wantResult: -1, wantDone: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotResult, gotDone := compareValidity(tt.a, tt.b, tt.o)
+			if gotResult != tt.wantResult || gotDone != tt.wantDone {
+				t.Errorf("compareValidity() = (%v, %v), want (%v, %v)", gotResult, gotDone, tt.wantResult, tt.wantDone)
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package generic
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"net"
+	"net/netip"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -29,6 +34,12 @@ func asBool(x interface{}) (result bool, isValid ValidFlag, err error) {
 			return result, false, ErrInvalidGenericValue{Value: x}
 		}
 		result = b
+	case driver.Valuer:
+		dv, err := t.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asBool(dv)
 	default:
 		return result, false, ErrInvalidGenericValue{Value: x}
 	}
@@ -76,6 +87,12 @@ func asFloat(x interface{}) (result float64, isValid ValidFlag, err error) {
 			return result, false, ErrInvalidGenericValue{Value: x}
 		}
 		result = f
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asFloat(dv)
 	default:
 		return result, false, ErrInvalidGenericValue{Value: x}
 	}
@@ -107,6 +124,12 @@ func asInt(x interface{}) (result int64, isValid ValidFlag, err error) {
 		if err != nil {
 			return 0, false, ErrInvalidGenericValue{Value: x}
 		}
+	case driver.Valuer:
+		dv, err := t.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asInt(dv)
 	default:
 		return result, false, ErrInvalidGenericValue{Value: x}
 	}
@@ -129,6 +152,12 @@ func asString(x interface{}) (result string, isValid ValidFlag, err error) {
 		result = strconv.FormatBool(x.(bool))
 	case string:
 		result = x.(string)
+	case driver.Valuer:
+		dv, err := t.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asString(dv)
 	default:
 		return result, false, ErrInvalidGenericValue{Value: x}
 	}
@@ -137,6 +166,11 @@ func asString(x interface{}) (result string, isValid ValidFlag, err error) {
 
 // asBool converts a specified value to time.Time value.
 func asTime(x interface{}) (result time.Time, isValid ValidFlag, err error) {
+	return asTimeWithOptions(x, DefaultTimeParseOptions)
+}
+
+// asTimeWithOptions converts a specified value to time.Time value, parsing strings with o.
+func asTimeWithOptions(x interface{}, o TimeParseOptions) (result time.Time, isValid ValidFlag, err error) {
 	switch v := x.(type) {
 	case nil:
 		return result, false, nil
@@ -145,6 +179,20 @@ func asTime(x interface{}) (result time.Time, isValid ValidFlag, err error) {
 		if result.IsZero() {
 			return result, true, nil
 		}
+	case string:
+		if result, err = parseTimeString(v, o); err != nil {
+			return result, false, err
+		}
+	case []byte:
+		if result, err = parseTimeString(string(v), o); err != nil {
+			return result, false, err
+		}
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asTimeWithOptions(dv, o)
 	default:
 		return result, false, ErrInvalidGenericValue{Value: x}
 	}
@@ -153,23 +201,22 @@ func asTime(x interface{}) (result time.Time, isValid ValidFlag, err error) {
 
 // asTimestamp converts a specified value to time.Time value.
 func asTimestamp(x interface{}) (result time.Time, isValid ValidFlag, err error) {
-	return asTimestampWithFunc(x, func(i int64) time.Time {
-		return time.Unix(i, 0)
-	})
+	return asTimestampWithUnit(x, time.Second, DefaultTimestampOptions)
 }
 
 // asTimestampNanoseconds converts a specified value to time.Time value.
 func asTimestampNanoseconds(x interface{}) (result time.Time, isValid ValidFlag, err error) {
-	return asTimestampWithFunc(x, func(i int64) time.Time {
-		return time.Unix(0, i)
-	})
+	return asTimestampWithUnit(x, time.Nanosecond, DefaultTimestampOptions)
 }
 
 // asTimestampMilliseconds converts a specified value to time.Time value.
 func asTimestampMilliseconds(x interface{}) (result time.Time, isValid ValidFlag, err error) {
-	return asTimestampWithFunc(x, func(i int64) time.Time {
-		return time.Unix(0, i*1000000)
-	})
+	return asTimestampWithUnit(x, time.Millisecond, DefaultTimestampOptions)
+}
+
+// asTimestampMicroseconds converts a specified value to time.Time value.
+func asTimestampMicroseconds(x interface{}) (result time.Time, isValid ValidFlag, err error) {
+	return asTimestampWithUnit(x, time.Microsecond, DefaultTimestampOptions)
 }
 
 // asBool converts a specified value to uint64 value.
@@ -209,13 +256,21 @@ func asUint(x interface{}) (result uint64, isValid ValidFlag, err error) {
 			return result, false, ErrInvalidGenericValue{Value: x}
 		}
 		result = u64
+	case driver.Valuer:
+		dv, err := t.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asUint(dv)
 	default:
 		return result, false, ErrInvalidGenericValue{Value: x}
 	}
 	return result, true, nil
 }
 
-func asTimestampWithFunc(x interface{}, f func(i int64) time.Time) (result time.Time, isValid ValidFlag, err error) {
+// asTimestampWithUnit converts a specified value to time.Time value, reading numbers and numeric strings as epochs in unit.
+// Fractional parts of floats and decimal strings are kept down to the nanosecond.
+func asTimestampWithUnit(x interface{}, unit time.Duration, o TimestampOptions) (result time.Time, isValid ValidFlag, err error) {
 	var i int64
 	switch t := x.(type) {
 	case nil:
@@ -227,23 +282,112 @@ func asTimestampWithFunc(x interface{}, f func(i int64) time.Time) (result time.
 		}
 		return result, true, nil
 	case string:
-		result, err = time.Parse(time.RFC3339Nano, x.(string))
-		return result, err == nil, err
+		if isEpochDecimal(t) {
+			return parseEpochDecimal(t, unit, o)
+		}
+		layouts := o.Layouts
+		if len(layouts) == 0 {
+			layouts = []string{time.RFC3339Nano}
+		}
+		if result, err = parseTimeString(t, TimeParseOptions{Layouts: layouts}); err != nil {
+			return result, false, err
+		}
+		return result, true, nil
 	case int, int8, int16, int32, int64:
 		i = reflect.ValueOf(t).Int()
 	case uint, uint8, uint16, uint32, uint64:
-		i = int64(reflect.ValueOf(t).Uint())
+		u := reflect.ValueOf(t).Uint()
+		if u > math.MaxInt64 {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+		i = int64(u)
 	case float32:
-		i = int64(x.(float32))
+		if math.IsNaN(float64(t)) || math.IsInf(float64(t), 0) {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+		return parseEpochDecimal(strconv.FormatFloat(float64(t), 'f', -1, 32), unit, o)
 	case float64:
-		i = int64(x.(float64))
+		if math.IsNaN(t) || math.IsInf(t, 0) {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+		return parseEpochDecimal(strconv.FormatFloat(t, 'f', -1, 64), unit, o)
+	case driver.Valuer:
+		dv, err := t.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asTimestampWithUnit(dv, unit, o)
 	default:
 		return result, false, ErrInvalidGenericValue{Value: x}
 	}
-	if i < 0 {
+	if i < 0 && o.RejectNegative {
 		return result, false, ErrInvalidGenericValue{Value: x}
 	}
-	return f(i), true, nil
+	return epochTime(i, unit), true, nil
+}
+
+// isEpochDecimal reports whether s is a decimal number such as "-1700000000.5".
+func isEpochDecimal(s string) bool {
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+	ip, fp := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		ip, fp = s[:i], s[i+1:]
+	}
+	return (ip != "" || fp != "") && isDigits(ip) && isDigits(fp)
+}
+
+// parseEpochDecimal converts a decimal number of units since the Unix epoch to time.Time value.
+// Digits below the nanosecond are truncated.
+func parseEpochDecimal(s string, unit time.Duration, o TimestampOptions) (result time.Time, isValid ValidFlag, err error) {
+	if !isEpochDecimal(s) {
+		return result, false, ErrInvalidGenericValue{Value: s}
+	}
+	neg := s[0] == '-'
+	s = strings.TrimLeft(s, "+-")
+	ip, fp := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		ip, fp = s[:i], s[i+1:]
+	}
+	var i int64
+	if ip != "" {
+		if i, err = strconv.ParseInt(ip, 10, 64); err != nil {
+			return result, false, ErrInvalidGenericValue{Value: s}
+		}
+	}
+	// the fraction of a unit, in billionths
+	if len(fp) > 9 {
+		fp = fp[:9]
+	}
+	var f int64
+	if fp != "" {
+		f, _ = strconv.ParseInt(fp+strings.Repeat("0", 9-len(fp)), 10, 64)
+	}
+	d := time.Duration(f * int64(unit) / 1e9)
+	if neg {
+		if o.RejectNegative && (i != 0 || f != 0) {
+			return result, false, ErrInvalidGenericValue{Value: "-" + s}
+		}
+		return epochTime(-i, unit).Add(-d), true, nil
+	}
+	return epochTime(i, unit).Add(d), true, nil
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// epochTime returns the time i units after the Unix epoch.
+func epochTime(i int64, unit time.Duration) time.Time {
+	per := int64(time.Second / unit)
+	return time.Unix(i/per, i%per*int64(unit))
 }
 
 func asURL(x interface{}) (result *url.URL, isValid ValidFlag, err error) {
@@ -254,8 +398,709 @@ func asURL(x interface{}) (result *url.URL, isValid ValidFlag, err error) {
 		result = v
 	case string:
 		result, err = url.Parse(v)
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return nil, false, err
+		}
+		return asURL(dv)
 	default:
 		err = ErrInvalidGenericValue{Value: x}
 	}
 	return result, (err == nil), err
 }
+
+// asPolicyURL converts a specified value to *url.URL allowed by p.
+// MaxLength is applied to strings as they are, before parsing.
+func asPolicyURL(x interface{}, p URLPolicy) (result *url.URL, isValid ValidFlag, err error) {
+	if s, ok := x.(string); ok {
+		if err = p.checkLength(s); err != nil {
+			return nil, false, err
+		}
+		if result, err = url.Parse(s); err != nil {
+			return nil, false, err
+		}
+		if err = p.checkRules(result); err != nil {
+			return nil, false, err
+		}
+		return result, true, nil
+	}
+	if result, isValid, err = asURL(x); err != nil || !isValid {
+		return result, isValid, err
+	}
+	if err = p.Check(result); err != nil {
+		return nil, false, err
+	}
+	return result, true, nil
+}
+
+func asUUID(x interface{}) (result [16]byte, isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return result, false, nil
+	case [16]byte:
+		result = v
+	case []byte:
+		if len(v) == 16 {
+			copy(result[:], v)
+			return result, true, nil
+		}
+		return asUUID(string(v))
+	case string:
+		var ok bool
+		if result, ok = parseUUID(v); !ok {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asUUID(dv)
+	default:
+		return result, false, ErrInvalidGenericValue{Value: x}
+	}
+	return result, true, nil
+}
+
+func asIP(x interface{}) (result netip.Addr, isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return result, false, nil
+	case netip.Addr:
+		if !v.IsValid() {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+		result = v
+	case net.IP:
+		var ok bool
+		if result, ok = netip.AddrFromSlice(v); !ok {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+		result = result.Unmap()
+	case []byte:
+		// drivers such as lib/pq return inet as text, so binary is tried only when the text is not an address
+		if result, isValid, err = asIP(string(v)); err == nil {
+			return result, isValid, nil
+		}
+		if len(v) == net.IPv4len || len(v) == net.IPv6len {
+			return asIP(net.IP(v))
+		}
+		return result, false, ErrInvalidGenericValue{Value: x}
+	case string:
+		// inet values of hosts may carry a netmask such as "192.168.0.1/24"
+		if i := strings.IndexByte(v, '/'); i >= 0 {
+			p, err := netip.ParsePrefix(v)
+			if err != nil {
+				return result, false, ErrInvalidGenericValue{Value: x}
+			}
+			return p.Addr(), true, nil
+		}
+		if result, err = netip.ParseAddr(v); err != nil {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asIP(dv)
+	default:
+		return result, false, ErrInvalidGenericValue{Value: x}
+	}
+	return result, true, nil
+}
+
+func asPrefix(x interface{}) (result netip.Prefix, isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return result, false, nil
+	case netip.Prefix:
+		if !v.IsValid() {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+		result = v
+	case *net.IPNet:
+		if v == nil {
+			return result, false, nil
+		}
+		a, ok := netip.AddrFromSlice(v.IP)
+		ones, bits := v.Mask.Size()
+		if !ok || bits == 0 {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+		// a v4-mapped address whose mask is shorter than the mapping prefix stays IPv6
+		if !(a.Is4In6() && bits == net.IPv6len*8 && ones < 96) {
+			a = a.Unmap()
+		}
+		result = netip.PrefixFrom(a, ones-(bits-a.BitLen()))
+		if !result.IsValid() {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+	case []byte:
+		return asPrefix(string(v))
+	case string:
+		// a host without a netmask is a prefix of the full address length
+		if strings.IndexByte(v, '/') < 0 {
+			a, err := netip.ParseAddr(v)
+			if err != nil {
+				return result, false, ErrInvalidGenericValue{Value: x}
+			}
+			return netip.PrefixFrom(a, a.BitLen()), true, nil
+		}
+		if result, err = netip.ParsePrefix(v); err != nil {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asPrefix(dv)
+	default:
+		return result, false, ErrInvalidGenericValue{Value: x}
+	}
+	return result, true, nil
+}
+
+func asEmail(x interface{}, o EmailOptions) (result emailAddress, isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return result, false, nil
+	case string:
+		if result, err = parseEmail(v, o); err != nil {
+			return result, false, err
+		}
+	case []byte:
+		return asEmail(string(v), o)
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asEmail(dv, o)
+	default:
+		return result, false, ErrInvalidGenericValue{Value: x}
+	}
+	return result, true, nil
+}
+
+func asEnum(x interface{}, s *EnumSet) (result EnumMember, isValid ValidFlag, err error) {
+	if x == nil {
+		return result, false, nil
+	}
+	if s == nil {
+		return result, false, ErrInvalidGenericValue{Value: x}
+	}
+	var ok bool
+	switch v := x.(type) {
+	case string:
+		if result, ok = s.Lookup(v); ok {
+			return result, true, nil
+		}
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+		result, ok = s.LookupOrdinal(i)
+	case []byte:
+		return asEnum(string(v), s)
+	case int, int8, int16, int32, int64:
+		result, ok = s.LookupOrdinal(reflect.ValueOf(v).Int())
+	case uint, uint8, uint16, uint32, uint64:
+		u := reflect.ValueOf(v).Uint()
+		if u <= math.MaxInt64 {
+			result, ok = s.LookupOrdinal(int64(u))
+		}
+	case float32, float64:
+		f := reflect.ValueOf(v).Float()
+		if f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+			result, ok = s.LookupOrdinal(int64(f))
+		}
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asEnum(dv, s)
+	}
+	if !ok {
+		return EnumMember{}, false, ErrInvalidGenericValue{Value: x}
+	}
+	return result, true, nil
+}
+
+// asBytes converts a specified value to []byte value.
+// The result never shares memory with x, because drivers reuse their buffers.
+func asBytes(x interface{}) (result []byte, isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return nil, false, nil
+	case []byte:
+		if v == nil {
+			return nil, false, nil
+		}
+		result = make([]byte, len(v))
+		copy(result, v)
+	case string:
+		result = []byte(v)
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return nil, false, err
+		}
+		return asBytes(dv)
+	default:
+		return nil, false, ErrInvalidGenericValue{Value: x}
+	}
+	return result, true, nil
+}
+
+// asJSON converts a specified value to a JSON document.
+// The result never shares memory with x, because drivers reuse their buffers.
+func asJSON(x interface{}) (result json.RawMessage, isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return nil, false, nil
+	case json.RawMessage:
+		return asJSON([]byte(v))
+	case []byte:
+		if v == nil {
+			return nil, false, nil
+		}
+		if !json.Valid(v) {
+			return nil, false, ErrInvalidGenericValue{Value: x}
+		}
+		result = make(json.RawMessage, len(v))
+		copy(result, v)
+	case string:
+		if !json.Valid([]byte(v)) {
+			return nil, false, ErrInvalidGenericValue{Value: x}
+		}
+		result = json.RawMessage(v)
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return nil, false, err
+		}
+		return asJSON(dv)
+	default:
+		return nil, false, ErrInvalidGenericValue{Value: x}
+	}
+	return result, true, nil
+}
+
+// asValue detects the kind of a specified value and normalizes it.
+func asValue(x interface{}) (result interface{}, kind Kind, err error) {
+	switch t := x.(type) {
+	case nil:
+		return nil, KindNull, nil
+	case bool:
+		return t, KindBool, nil
+	case int, int8, int16, int32, int64:
+		return reflect.ValueOf(t).Int(), KindInt, nil
+	case uint, uint8, uint16, uint32, uint64:
+		return reflect.ValueOf(t).Uint(), KindUint, nil
+	case float32, float64:
+		return reflect.ValueOf(t).Float(), KindFloat, nil
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i, KindInt, nil
+		}
+		if u, err := strconv.ParseUint(string(t), 10, 64); err == nil {
+			return u, KindUint, nil
+		}
+		f, err := t.Float64()
+		if err != nil {
+			return nil, KindNull, ErrInvalidGenericValue{Value: x}
+		}
+		return f, KindFloat, nil
+	case string:
+		return t, KindString, nil
+	case time.Time:
+		return t, KindTime, nil
+	case []byte:
+		if t == nil {
+			return nil, KindNull, nil
+		}
+		b := make([]byte, len(t))
+		copy(b, t)
+		return b, KindBytes, nil
+	case driver.Valuer:
+		dv, err := t.Value()
+		if err != nil {
+			return nil, KindNull, err
+		}
+		return asValue(dv)
+	}
+	return nil, KindNull, ErrInvalidGenericValue{Value: x}
+}
+
+// asMoney converts a specified value to an amount of money.
+// x may be text such as "12.34 USD" or a composite literal such as "(12.34,USD)",
+// or a two-element slice of the amount and currency columns.
+func asMoney(x interface{}) (result moneyAmount, isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return result, false, nil
+	case string:
+		return parseMoneyText(v)
+	case []byte:
+		return parseMoneyText(string(v))
+	case []interface{}:
+		if len(v) != 2 {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+		return asMoneyColumns(v[0], v[1])
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asMoney(dv)
+	default:
+		return result, false, ErrInvalidGenericValue{Value: x}
+	}
+}
+
+func parseMoneyText(s string) (result moneyAmount, isValid ValidFlag, err error) {
+	s = strings.TrimSpace(s)
+	var parts []string
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		parts = strings.Split(s[1:len(s)-1], ",")
+		for i := range parts {
+			parts[i] = strings.Trim(strings.TrimSpace(parts[i]), `"`)
+		}
+		if len(parts) == 2 && parts[0] == "" {
+			// the amount field of the composite value is NULL
+			return result, false, nil
+		}
+	} else {
+		parts = strings.Fields(s)
+	}
+	if len(parts) != 2 {
+		return result, false, ErrInvalidGenericValue{Value: s}
+	}
+	return asMoneyColumns(parts[0], parts[1])
+}
+
+// asMoneyColumns converts a pair of amount and currency values to an amount of money.
+// The amount is in major units, such as 12.34 for USD, and is rounded to the minor units of the currency.
+func asMoneyColumns(amount, currency interface{}) (result moneyAmount, isValid ValidFlag, err error) {
+	if amount == nil {
+		return result, false, nil
+	}
+	if b, ok := currency.([]byte); ok {
+		currency = string(b)
+	}
+	code, ok, err := asString(currency)
+	if err != nil || !ok {
+		return result, false, ErrInvalidGenericValue{Value: currency}
+	}
+	if result.currency, err = normalizeCurrency(code); err != nil {
+		return result, false, err
+	}
+	minor := currencyMinorUnits[result.currency]
+	switch t := amount.(type) {
+	case string:
+		result.amount, err = parseMinorUnits(t, minor)
+	case []byte:
+		result.amount, err = parseMinorUnits(string(t), minor)
+	case float32, float64:
+		f := reflect.ValueOf(t).Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return moneyAmount{}, false, ErrInvalidGenericValue{Value: amount}
+		}
+		result.amount, err = parseMinorUnits(strconv.FormatFloat(f, 'f', -1, 64), minor)
+	case int, int8, int16, int32, int64:
+		result.amount, err = scaleMinorUnits(reflect.ValueOf(t).Int(), minor)
+	case uint, uint8, uint16, uint32, uint64:
+		u := reflect.ValueOf(t).Uint()
+		if u > math.MaxInt64 {
+			return moneyAmount{}, false, ErrOverflow
+		}
+		result.amount, err = scaleMinorUnits(int64(u), minor)
+	case driver.Valuer:
+		dv, err := t.Value()
+		if err != nil {
+			return moneyAmount{}, false, err
+		}
+		return asMoneyColumns(dv, currency)
+	default:
+		return moneyAmount{}, false, ErrInvalidGenericValue{Value: amount}
+	}
+	if err != nil {
+		return moneyAmount{}, false, err
+	}
+	return result, true, nil
+}
+
+// asZonedTime converts a specified value to a time with its zone.
+// Strings may carry the IANA zone name in brackets, as in "2024-01-02T03:04:05+09:00[Asia/Tokyo]".
+func asZonedTime(x interface{}) (result zonedTime, isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return result, false, nil
+	case time.Time:
+		return zonedTime{time: v, zone: zoneName(v.Location())}, true, nil
+	case string:
+		return parseZonedTime(v)
+	case []byte:
+		return parseZonedTime(string(v))
+	case []interface{}:
+		if len(v) != 2 {
+			return result, false, ErrInvalidGenericValue{Value: x}
+		}
+		return asZonedTimeColumns(v[0], v[1])
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asZonedTime(dv)
+	default:
+		return result, false, ErrInvalidGenericValue{Value: x}
+	}
+}
+
+func parseZonedTime(s string) (result zonedTime, isValid ValidFlag, err error) {
+	s = strings.TrimSpace(s)
+	var zone interface{}
+	// the first bracketed suffix without a key is the zone, and the others are ignored
+	for strings.HasSuffix(s, "]") {
+		i := strings.LastIndexByte(s, '[')
+		if i < 0 {
+			return result, false, ErrInvalidGenericValue{Value: s}
+		}
+		if a := strings.TrimPrefix(s[i+1:len(s)-1], "!"); !strings.Contains(a, "=") {
+			zone = a
+		}
+		s = s[:i]
+	}
+	return asZonedTimeColumns(s, zone)
+}
+
+// asZonedTimeColumns converts a pair of timestamp and IANA zone name values to a time with its zone.
+// Times are converted into the zone, and strings without an offset are read as wall clock times of the zone.
+// If the zone is NULL, the timestamp is kept as it is.
+func asZonedTimeColumns(timestamp, zone interface{}) (result zonedTime, isValid ValidFlag, err error) {
+	if timestamp == nil {
+		return result, false, nil
+	}
+	if b, ok := zone.([]byte); ok {
+		zone = string(b)
+	}
+	name, ok, err := asString(zone)
+	if err != nil {
+		return result, false, ErrInvalidGenericValue{Value: zone}
+	}
+	if !ok {
+		// keep the offset of the timestamp, because there is no zone to convert it into
+		t, ok, err := asTimeWithOptions(timestamp, DefaultTimeParseOptions)
+		if err != nil || !ok {
+			return result, false, err
+		}
+		return zonedTime{time: t, zone: zoneName(t.Location())}, true, nil
+	}
+	loc, err := loadZone(name)
+	if err != nil {
+		return result, false, ErrInvalidGenericValue{Value: zone}
+	}
+	o := DefaultTimeParseOptions
+	o.Location = loc
+	t, ok, err := asTimeWithOptions(timestamp, o)
+	if err != nil || !ok {
+		return result, false, err
+	}
+	return zonedTime{time: t.In(loc), zone: name}, true, nil
+}
+
+// asTimeRange converts a specified value to a range of time.
+// x may be a PostgreSQL tstzrange or tsrange literal as string or []byte.
+func asTimeRange(x interface{}) (result rangeOf[time.Time], isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return result, false, nil
+	case string:
+		return parseTimeRange(v)
+	case []byte:
+		return parseTimeRange(string(v))
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asTimeRange(dv)
+	default:
+		return result, false, ErrInvalidGenericValue{Value: x}
+	}
+}
+
+func parseTimeRange(s string) (result rangeOf[time.Time], isValid ValidFlag, err error) {
+	l, err := parseRangeLiteral(s)
+	if err != nil {
+		return result, false, err
+	}
+	if l.empty {
+		return rangeOf[time.Time]{empty: true}, true, nil
+	}
+	// infinite timestamps are read as unbounded
+	bound := func(s string, ok bool) (time.Time, bool, error) {
+		if !ok || strings.EqualFold(s, "infinity") || strings.EqualFold(s, "-infinity") {
+			return time.Time{}, false, nil
+		}
+		t, err := parseTimeString(s, DefaultTimeParseOptions)
+		return t, err == nil, err
+	}
+	lower, hasLower, err := bound(l.lower, l.hasLower)
+	if err != nil {
+		return result, false, err
+	}
+	upper, hasUpper, err := bound(l.upper, l.hasUpper)
+	if err != nil {
+		return result, false, err
+	}
+	if result, err = newRange(lower, hasLower, upper, hasUpper, l.bounds, compareTime); err != nil {
+		return result, false, err
+	}
+	return result, true, nil
+}
+
+// compareTime returns -1, 0 or +1 depending on whether a is before, equal to or after b.
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+// asIntRange converts a specified value to a canonical range of int64.
+// x may be a PostgreSQL int8range literal or a range such as "1..10" as string or []byte.
+func asIntRange(x interface{}) (result rangeOf[int64], isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return result, false, nil
+	case string:
+		return parseIntRange(v)
+	case []byte:
+		return parseIntRange(string(v))
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asIntRange(dv)
+	default:
+		return result, false, ErrInvalidGenericValue{Value: x}
+	}
+}
+
+func parseIntRange(s string) (result rangeOf[int64], isValid ValidFlag, err error) {
+	l, err := parseNumericRangeLiteral(s)
+	if err != nil {
+		return result, false, err
+	}
+	if l.empty {
+		return rangeOf[int64]{empty: true}, true, nil
+	}
+	bound := func(s string, ok bool) (int64, error) {
+		if !ok {
+			return 0, nil
+		}
+		i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return 0, ErrInvalidGenericValue{Value: s}
+		}
+		return i, nil
+	}
+	lower, err := bound(l.lower, l.hasLower)
+	if err != nil {
+		return result, false, err
+	}
+	upper, err := bound(l.upper, l.hasUpper)
+	if err != nil {
+		return result, false, err
+	}
+	if result, err = newIntRange(lower, l.hasLower, upper, l.hasUpper, l.bounds); err != nil {
+		return result, false, err
+	}
+	return result, true, nil
+}
+
+// asFloatRange converts a specified value to a range of float64.
+// x may be a PostgreSQL numrange literal or a range such as "1.5..10" as string or []byte.
+func asFloatRange(x interface{}) (result rangeOf[float64], isValid ValidFlag, err error) {
+	switch v := x.(type) {
+	case nil:
+		return result, false, nil
+	case string:
+		return parseFloatRange(v)
+	case []byte:
+		return parseFloatRange(string(v))
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return result, false, err
+		}
+		return asFloatRange(dv)
+	default:
+		return result, false, ErrInvalidGenericValue{Value: x}
+	}
+}
+
+func parseFloatRange(s string) (result rangeOf[float64], isValid ValidFlag, err error) {
+	l, err := parseNumericRangeLiteral(s)
+	if err != nil {
+		return result, false, err
+	}
+	if l.empty {
+		return rangeOf[float64]{empty: true}, true, nil
+	}
+	bound := func(s string, ok bool) (float64, error) {
+		if !ok {
+			return 0, nil
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return 0, ErrInvalidGenericValue{Value: s}
+		}
+		return f, nil
+	}
+	lower, err := bound(l.lower, l.hasLower)
+	if err != nil {
+		return result, false, err
+	}
+	upper, err := bound(l.upper, l.hasUpper)
+	if err != nil {
+		return result, false, err
+	}
+	if result, err = newFloatRange(lower, l.hasLower, upper, l.hasUpper, l.bounds); err != nil {
+		return result, false, err
+	}
+	return result, true, nil
+}
+
+// compareInt64 returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b.
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareFloat64 returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b.
+func compareFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
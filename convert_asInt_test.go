@@ -1,6 +1,11 @@
 package generic
 
-import "testing"
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
 
 func TestAsIntInt(t *testing.T) {
 	i := int(100)
@@ -111,6 +116,44 @@ func TestAsIntInvalidType(t *testing.T) {
 	}
 }
 
+func TestAsIntNullInt64(t *testing.T) {
+	asIntTest(sql.NullInt64{Int64: 100, Valid: true}, t)
+}
+
+func TestAsIntNullInt64Invalid(t *testing.T) {
+	_, v, err := asInt(sql.NullInt64{Int64: 100})
+	if err != nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
+	}
+	if v {
+		t.Error("expected: false, actual: true")
+	}
+}
+
+func TestAsIntNullString(t *testing.T) {
+	asIntTest(sql.NullString{String: "100", Valid: true}, t)
+}
+
+func TestAsIntGenericValuer(t *testing.T) {
+	asIntTest(MustFloat(100.5), t)
+}
+
+type errValuer struct{}
+
+func (errValuer) Value() (driver.Value, error) {
+	return nil, errors.New("valuer error")
+}
+
+func TestAsIntValuerError(t *testing.T) {
+	_, v, err := asInt(errValuer{})
+	if err == nil {
+		t.Error("Expected error")
+	}
+	if v {
+		t.Error("expected: false, actual: true")
+	}
+}
+
 func asIntTest(x interface{}, t *testing.T) {
 	r, v, err := asInt(x)
 	if err != nil {
@@ -1,6 +1,7 @@
 package generic
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 )
@@ -32,3 +33,51 @@ func TestAsTimeZero(t *testing.T) {
 		t.Errorf("expected: time.IsZero is true, actual: %s", r.String())
 	}
 }
+
+func TestAsTimeNullTime(t *testing.T) {
+	x := time.Date(2020, time.Month(7), 24, 20, 0, 0, 0, time.UTC)
+	r, v, err := asTime(sql.NullTime{Time: x, Valid: true})
+	if err != nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
+	}
+	if !v {
+		t.Error("expected: true, actual: false")
+	}
+	if !r.Equal(x) {
+		t.Errorf("expected: %s, actual: %s", x, r)
+	}
+}
+
+func TestAsTimeString(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		name    string
+		args    interface{}
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "RFC3339", args: "2024-01-02T03:04:05Z", want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "RFC3339 with offset", args: "2024-01-02T03:04:05.5+09:00", want: time.Date(2024, 1, 2, 3, 4, 5, 500000000, jst)},
+		{name: "ISO without zone", args: "2024-01-02T03:04:05", want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "SQL", args: []byte("2024-01-02 03:04:05"), want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "PostgreSQL timestamptz", args: "2024-01-02 03:04:05.123456+09", want: time.Date(2024, 1, 2, 3, 4, 5, 123456000, jst)},
+		{name: "RFC1123", args: "Tue, 02 Jan 2024 03:04:05 GMT", want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "date only", args: "2024-01-02", want: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "unknown layout", args: "02/01/2024", wantErr: true},
+		{name: "empty", args: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, valid, err := asTime(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("asTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if bool(valid) == tt.wantErr {
+				t.Errorf("asTime() valid = %v", valid)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("asTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAsTimestampWithUnitFraction(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    interface{}
+		unit    time.Duration
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "float seconds", args: 1700000000.123456, unit: time.Second, want: time.Unix(1700000000, 123456000)},
+		{name: "float32 seconds", args: float32(1.5), unit: time.Second, want: time.Unix(1, 500000000)},
+		{name: "decimal string seconds", args: "1700000000.5", unit: time.Second, want: time.Unix(1700000000, 500000000)},
+		{name: "float milliseconds", args: 1700000000123.5, unit: time.Millisecond, want: time.Unix(1700000000, 123500000)},
+		{name: "decimal string microseconds", args: "1700000000123456.789", unit: time.Microsecond, want: time.Unix(1700000000, 123456789)},
+		{name: "float nanoseconds", args: 1.9, unit: time.Nanosecond, want: time.Unix(0, 1)},
+		{name: "sub-nanosecond digits are truncated", args: "0.1234567899", unit: time.Second, want: time.Unix(0, 123456789)},
+		{name: "leading dot", args: ".25", unit: time.Second, want: time.Unix(0, 250000000)},
+		{name: "integer microseconds", args: int64(1700000000123456), unit: time.Microsecond, want: time.Unix(1700000000, 123456000)},
+		{name: "RFC3339 still works", args: "2023-11-14T22:13:20.5Z", unit: time.Second, want: time.Unix(1700000000, 500000000)},
+		{name: "negative float", args: -1.5, unit: time.Second, wantErr: true},
+		{name: "bad decimal", args: "1.2.3", unit: time.Second, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, valid, err := asTimestampWithUnit(tt.args, tt.unit, TimestampOptions{RejectNegative: true})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("asTimestampWithUnit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !bool(valid) || !got.Equal(tt.want) {
+				t.Errorf("asTimestampWithUnit() = %v, %v, want %v", got, valid, tt.want)
+			}
+		})
+	}
+}
+
+func TestAsTimestampWithUnitNegative(t *testing.T) {
+	tests := []struct {
+		name string
+		args interface{}
+		unit time.Duration
+		want time.Time
+	}{
+		{name: "seconds", args: int64(-86400), unit: time.Second, want: time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "milliseconds", args: -1500, unit: time.Millisecond, want: time.Unix(-2, 500000000)},
+		{name: "microseconds", args: int32(-1), unit: time.Microsecond, want: time.Unix(0, -1000)},
+		{name: "nanoseconds", args: int64(-1), unit: time.Nanosecond, want: time.Unix(0, -1)},
+		{name: "float seconds", args: -1.25, unit: time.Second, want: time.Unix(-2, 750000000)},
+		{name: "decimal string", args: "-0.5", unit: time.Second, want: time.Unix(0, -500000000)},
+		{name: "before 1678 in seconds", args: int64(-10000000000), unit: time.Second, want: time.Unix(-10000000000, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, valid, err := asTimestampWithUnit(tt.args, tt.unit, TimestampOptions{})
+			if err != nil || !bool(valid) || !got.Equal(tt.want) {
+				t.Errorf("asTimestampWithUnit() = %v, %v, %v, want %v", got, valid, err, tt.want)
+			}
+			if _, _, err = asTimestampWithUnit(tt.args, tt.unit, TimestampOptions{RejectNegative: true}); err == nil {
+				t.Error("asTimestampWithUnit() expected error with RejectNegative")
+			}
+		})
+	}
+	if _, _, err := asTimestampWithUnit(uint64(1)<<63, time.Second, TimestampOptions{}); err == nil {
+		t.Error("asTimestampWithUnit() expected error for uint64 overflow")
+	}
+}
+
+func TestTimestampEpoch(t *testing.T) {
+	ms := MustTimestampMS(-1500)
+	if i, ok := ms.Epoch(); i != -1500 || !ok {
+		t.Errorf("TimestampMS.Epoch() = %d, %v, want -1500, true", i, ok)
+	}
+	if got, _ := ms.Value(); got != int64(-1500) {
+		t.Errorf("TimestampMS.Value() = %v, want -1500", got)
+	}
+	if ms.String() != "-1500" {
+		t.Errorf("TimestampMS.String() = %s, want -1500", ms.String())
+	}
+	us := MustTimestampMicro(0)
+	if i, ok := us.Epoch(); i != 0 || !ok {
+		t.Errorf("TimestampMicro.Epoch() = %d, %v, want 0, true", i, ok)
+	}
+	if us.String() != "0" {
+		t.Errorf("TimestampMicro.String() = %s, want 0", us.String())
+	}
+	if i, ok := (Timestamp{}).Epoch(); i != 0 || ok {
+		t.Errorf("Timestamp.Epoch() = %d, %v, want 0, false", i, ok)
+	}
+	if s := (TimestampNano{}).String(); s != "" {
+		t.Errorf("TimestampNano.String() = %s, want empty", s)
+	}
+	if b, _ := MustTimestamp(int64(-86400)).MarshalJSON(); string(b) != "-86400" {
+		t.Errorf("Timestamp.MarshalJSON() = %s, want -86400", b)
+	}
+}
+
+func TestDefaultTimestampOptions(t *testing.T) {
+	saved := DefaultTimestampOptions
+	defer func() { DefaultTimestampOptions = saved }()
+	DefaultTimestampOptions.RejectNegative = true
+	if _, err := MarshalTimestampNano(-1); err == nil {
+		t.Error("MarshalTimestampNano() expected error with RejectNegative")
+	}
+	if _, err := MarshalTimestampNano(time.Unix(-1, 0)); err != nil {
+		t.Errorf("MarshalTimestampNano() error = %v, time.Time values are not epochs", err)
+	}
+}
+
+func TestAsTimestampWithUnitStrings(t *testing.T) {
+	o := TimestampOptions{Layouts: []string{"2006-01-02 15:04:05", time.RFC1123}}
+	tests := []struct {
+		name    string
+		args    string
+		unit    time.Duration
+		o       TimestampOptions
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "seconds", args: "1700000000", unit: time.Second, want: time.Unix(1700000000, 0)},
+		{name: "milliseconds", args: "1700000000123", unit: time.Millisecond, want: time.Unix(1700000000, 123000000)},
+		{name: "signed", args: "+10", unit: time.Microsecond, want: time.Unix(0, 10000)},
+		{name: "default layout", args: "2023-11-14T22:13:20Z", unit: time.Second, want: time.Unix(1700000000, 0)},
+		{name: "layout", args: "2023-11-14 22:13:20", unit: time.Second, o: o, want: time.Unix(1700000000, 0)},
+		{name: "second layout", args: "Tue, 14 Nov 2023 22:13:20 UTC", unit: time.Second, o: o, want: time.Unix(1700000000, 0)},
+		{name: "layouts replace the default", args: "2023-11-14T22:13:20Z", unit: time.Second, o: o, wantErr: true},
+		{name: "not numeric", args: "1e9", unit: time.Second, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := asTimestampWithUnit(tt.args, tt.unit, tt.o)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("asTimestampWithUnit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("asTimestampWithUnit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimestampJsonUnmarshalQuotedEpoch(t *testing.T) {
+	var v struct {
+		Created  Timestamp   `json:"created"`
+		Modified TimestampMS `json:"modified"`
+	}
+	if err := json.Unmarshal([]byte(`{"created":"1700000000","modified":"1700000000123"}`), &v); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if v.Created.Int64() != 1700000000 || v.Modified.Int64() != 1700000000123 {
+		t.Errorf("json.Unmarshal() = %v, %v", v.Created, v.Modified)
+	}
+}
@@ -3,6 +3,7 @@ package generic
 import (
 	"bytes"
 	"database/sql/driver"
+	"errors"
 	"reflect"
 )
 
@@ -20,6 +21,14 @@ type ErrInvalidGenericValue struct {
 	Value interface{}
 }
 
+var (
+	// ErrOverflow is returned when the result of an arithmetic operation cannot be represented
+	ErrOverflow = errors.New("arithmetic overflow")
+
+	// ErrDivideByZero is returned when a division or modulo has a zero divisor
+	ErrDivideByZero = errors.New("division by zero")
+)
+
 // ValidFlag is the flag to check that value is valid
 type ValidFlag bool
 
@@ -51,3 +60,14 @@ func (e ErrInvalidGenericValue) Error() string {
 
 	return buf.String()
 }
+
+// Coalesce returns the first valid value in values.
+// If no value is valid, Coalesce returns nil.
+func Coalesce(values ...Type) Type {
+	for _, v := range values {
+		if v != nil && v.Valid() {
+			return v
+		}
+	}
+	return nil
+}
@@ -26,3 +26,18 @@ func TestValidFlagReset(t *testing.T) {
 		t.Error("actual:true, expected:false")
 	}
 }
+
+func TestCoalesce(t *testing.T) {
+	a := Int{}
+	b := MustInt(2)
+	c := MustString("3")
+	if got := Coalesce(&a, nil, &b, &c); got != &b {
+		t.Errorf("Coalesce() = %v, want %v", got, &b)
+	}
+	if got := Coalesce(&a); got != nil {
+		t.Errorf("Coalesce() = %v, want nil", got)
+	}
+	if got := Coalesce(); got != nil {
+		t.Errorf("Coalesce() = %v, want nil", got)
+	}
+}
@@ -1,5 +1,14 @@
 module github.com/usk81/generic/v2
 
-go 1.12
+go 1.18
 
-require github.com/stretchr/testify v1.3.0
+require (
+	github.com/stretchr/testify v1.3.0
+	golang.org/x/net v0.25.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/text v0.15.0 // indirect
+)
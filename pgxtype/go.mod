@@ -0,0 +1,17 @@
+module github.com/usk81/generic/v2/pgxtype
+
+go 1.20
+
+require (
+	github.com/jackc/pgx/v5 v5.6.0
+	github.com/usk81/generic/v2 v2.1.0
+)
+
+require (
+	golang.org/x/net v0.25.0 // indirect
+	golang.org/x/text v0.15.0 // indirect
+)
+
+// The replace only applies inside this repository. Tag github.com/usk81/generic/v2 v2.1.0,
+// which adds the types registered here, before tagging a release of this module.
+replace github.com/usk81/generic/v2 => ../
@@ -0,0 +1,174 @@
+// Package pgxtype lets generic types be encoded and decoded natively by github.com/jackc/pgx/v5.
+//
+// Without registration pgx only sees the database/sql interfaces of generic types and
+// falls back to the text format. Register teaches a pgtype.Map to treat each generic type
+// as the matching pgtype value, so the binary protocol is used and invalid values map to NULL.
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		pgxtype.Register(conn.TypeMap())
+//		return nil
+//	}
+package pgxtype
+
+import (
+	"reflect"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/usk81/generic/v2"
+)
+
+// typeNames is the list of PostgreSQL types whose codecs are wrapped by Register.
+var typeNames = []string{
+	"bool",
+	"int2",
+	"int4",
+	"int8",
+	"numeric",
+	"float4",
+	"float8",
+	"text",
+	"varchar",
+	"bpchar",
+	"name",
+	"timestamp",
+	"timestamptz",
+}
+
+// Register registers generic types on m.
+// It should be called before m is used, typically in pgx.ConnConfig.AfterConnect.
+// Calling it again on the same m has no effect.
+func Register(m *pgtype.Map) {
+	for _, name := range typeNames {
+		t, ok := m.TypeForName(name)
+		if !ok {
+			continue
+		}
+		if _, ok := t.Codec.(*codec); ok {
+			continue
+		}
+		m.RegisterType(&pgtype.Type{Name: t.Name, OID: t.OID, Codec: &codec{Codec: t.Codec}})
+	}
+	if !hasEncodePlan(m) {
+		m.TryWrapEncodePlanFuncs = append([]pgtype.TryWrapEncodePlanFunc{tryWrapEncodePlan}, m.TryWrapEncodePlanFuncs...)
+	}
+}
+
+// hasEncodePlan reports whether tryWrapEncodePlan is already registered on m.
+// Functions are not comparable, so they are compared by their entry points.
+func hasEncodePlan(m *pgtype.Map) bool {
+	p := reflect.ValueOf(tryWrapEncodePlan).Pointer()
+	for _, f := range m.TryWrapEncodePlanFuncs {
+		if reflect.ValueOf(f).Pointer() == p {
+			return true
+		}
+	}
+	return false
+}
+
+// codec wraps a pgtype.Codec so that pointers to generic types can be scanned into.
+// pgx plans sql.Scanner before its TryWrapScanPlanFuncs, so the scan side must be hooked into the codec itself.
+type codec struct {
+	pgtype.Codec
+}
+
+// PlanScan implements the pgtype.Codec interface.
+func (c *codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	next, ok := wrapTarget(target)
+	if !ok {
+		return c.Codec.PlanScan(m, oid, format, target)
+	}
+	plan := c.Codec.PlanScan(m, oid, format, next)
+	if plan == nil {
+		return nil
+	}
+	return &wrapScanPlan{next: plan}
+}
+
+// wrapScanPlan converts the target to its wrapper before delegating to the next plan.
+type wrapScanPlan struct {
+	next pgtype.ScanPlan
+}
+
+func (p *wrapScanPlan) Scan(src []byte, target any) error {
+	next, _ := wrapTarget(target)
+	return p.next.Scan(src, next)
+}
+
+// wrapEncodePlan converts the value to its wrapper before delegating to the next plan.
+type wrapEncodePlan struct {
+	next pgtype.EncodePlan
+}
+
+func (p *wrapEncodePlan) SetNext(next pgtype.EncodePlan) {
+	p.next = next
+}
+
+func (p *wrapEncodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	next, _ := wrapValue(value)
+	return p.next.Encode(next, buf)
+}
+
+func tryWrapEncodePlan(value any) (plan pgtype.WrappedEncodePlanNextSetter, nextValue any, ok bool) {
+	if nextValue, ok = wrapValue(value); !ok {
+		return nil, nil, false
+	}
+	return &wrapEncodePlan{}, nextValue, true
+}
+
+// wrapTarget returns the wrapper implementing pgtype scanner interfaces for a pointer to a generic type.
+func wrapTarget(target any) (any, bool) {
+	switch t := target.(type) {
+	case *generic.Bool:
+		return (*boolWrapper)(t), true
+	case *generic.Float:
+		return (*floatWrapper)(t), true
+	case *generic.Int:
+		return (*intWrapper)(t), true
+	case *generic.String:
+		return (*stringWrapper)(t), true
+	case *generic.Time:
+		return (*timeWrapper)(t), true
+	case *generic.Timestamp:
+		return (*timestampWrapper)(t), true
+	case *generic.TimestampMS:
+		return (*timestampMSWrapper)(t), true
+	case *generic.TimestampMicro:
+		return (*timestampMicroWrapper)(t), true
+	case *generic.TimestampNano:
+		return (*timestampNanoWrapper)(t), true
+	case *generic.Uint:
+		return (*uintWrapper)(t), true
+	case *generic.URL:
+		return (*urlWrapper)(t), true
+	}
+	return nil, false
+}
+
+// wrapValue returns the wrapper implementing pgtype valuer interfaces for a generic type.
+func wrapValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case generic.Bool:
+		return boolWrapper(v), true
+	case generic.Float:
+		return floatWrapper(v), true
+	case generic.Int:
+		return intWrapper(v), true
+	case generic.String:
+		return stringWrapper(v), true
+	case generic.Time:
+		return timeWrapper(v), true
+	case generic.Timestamp:
+		return timestampWrapper(v), true
+	case generic.TimestampMS:
+		return timestampMSWrapper(v), true
+	case generic.TimestampMicro:
+		return timestampMicroWrapper(v), true
+	case generic.TimestampNano:
+		return timestampNanoWrapper(v), true
+	case generic.Uint:
+		return uintWrapper(v), true
+	case generic.URL:
+		return urlWrapper(v), true
+	}
+	return nil, false
+}
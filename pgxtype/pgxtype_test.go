@@ -0,0 +1,218 @@
+package pgxtype
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/usk81/generic/v2"
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	Register(m)
+	return m
+}
+
+func TestEncodeBinary(t *testing.T) {
+	ts := time.Date(2020, 7, 24, 20, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		oid    uint32
+		value  interface{}
+		native interface{}
+	}{
+		{name: "bool", oid: pgtype.BoolOID, value: generic.MustBool(true), native: true},
+		{name: "int8", oid: pgtype.Int8OID, value: generic.MustInt(-42), native: int64(-42)},
+		{name: "int4", oid: pgtype.Int4OID, value: generic.MustInt(42), native: int32(42)},
+		{name: "int8 from uint", oid: pgtype.Int8OID, value: generic.MustUint(42), native: int64(42)},
+		{name: "numeric from int", oid: pgtype.NumericOID, value: generic.MustInt(12345), native: int64(12345)},
+		{name: "float8", oid: pgtype.Float8OID, value: generic.MustFloat(1.5), native: 1.5},
+		{name: "numeric from float", oid: pgtype.NumericOID, value: generic.MustFloat(1.25), native: 1.25},
+		{name: "text", oid: pgtype.TextOID, value: generic.MustString("foo"), native: "foo"},
+		{name: "text from url", oid: pgtype.TextOID, value: generic.MustURL("https://example.com/a"), native: "https://example.com/a"},
+		{name: "timestamptz", oid: pgtype.TimestamptzOID, value: generic.MustTime(ts), native: ts},
+		{name: "timestamp", oid: pgtype.TimestampOID, value: generic.MustTime(ts), native: ts},
+		{name: "timestamptz from timestamp", oid: pgtype.TimestamptzOID, value: generic.MustTimestamp(ts), native: ts},
+		{name: "int8 from timestampms", oid: pgtype.Int8OID, value: generic.MustTimestampMS(ts), native: ts.UnixNano() / int64(time.Millisecond)},
+		{name: "int8 from timestampmicro", oid: pgtype.Int8OID, value: generic.MustTimestampMicro(ts), native: ts.UnixMicro()},
+		{name: "pointer", oid: pgtype.Int8OID, value: func() *generic.Int { v := generic.MustInt(7); return &v }(), native: int64(7)},
+	}
+	m := newMap()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.Encode(tt.oid, pgtype.BinaryFormatCode, tt.value, nil)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			want, err := m.Encode(tt.oid, pgtype.BinaryFormatCode, tt.native, nil)
+			if err != nil {
+				t.Fatalf("Encode() native error = %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("Encode() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEncodeInvalidAsNull(t *testing.T) {
+	m := newMap()
+	values := []interface{}{
+		generic.Bool{},
+		generic.Float{},
+		generic.Int{},
+		generic.String{},
+		generic.Time{},
+		generic.Timestamp{},
+		generic.TimestampMS{},
+		generic.TimestampNano{},
+		generic.Uint{},
+		generic.URL{},
+	}
+	oids := []uint32{pgtype.BoolOID, pgtype.Float8OID, pgtype.Int8OID, pgtype.TextOID, pgtype.TimestamptzOID, pgtype.TimestamptzOID, pgtype.TimestamptzOID, pgtype.TimestamptzOID, pgtype.Int8OID, pgtype.TextOID}
+	for i, v := range values {
+		got, err := m.Encode(oids[i], pgtype.BinaryFormatCode, v, nil)
+		if err != nil {
+			t.Errorf("Encode(%T) error = %v", v, err)
+			continue
+		}
+		if got != nil {
+			t.Errorf("Encode(%T) = %v, want NULL", v, got)
+		}
+	}
+}
+
+func TestEncodeUintOverflow(t *testing.T) {
+	m := newMap()
+	if _, err := m.Encode(pgtype.Int8OID, pgtype.BinaryFormatCode, generic.MustUint(uint64(1)<<63), nil); err == nil {
+		t.Error("Encode() expected overflow error")
+	}
+}
+
+func TestScanBinary(t *testing.T) {
+	m := newMap()
+	ts := time.Date(2020, 7, 24, 20, 0, 0, 0, time.UTC)
+	encode := func(oid uint32, v interface{}) []byte {
+		buf, err := m.Encode(oid, pgtype.BinaryFormatCode, v, nil)
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+		return buf
+	}
+
+	var i generic.Int
+	if err := m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, encode(pgtype.Int8OID, int64(42)), &i); err != nil {
+		t.Fatalf("Scan(Int) error = %v", err)
+	}
+	if i != generic.MustInt(42) {
+		t.Errorf("Scan(Int) = %v, want 42", i)
+	}
+
+	if err := m.Scan(pgtype.NumericOID, pgtype.BinaryFormatCode, encode(pgtype.NumericOID, int64(123)), &i); err != nil {
+		t.Fatalf("Scan(Int) from numeric error = %v", err)
+	}
+	if i != generic.MustInt(123) {
+		t.Errorf("Scan(Int) from numeric = %v, want 123", i)
+	}
+
+	var f generic.Float
+	if err := m.Scan(pgtype.NumericOID, pgtype.BinaryFormatCode, encode(pgtype.NumericOID, 1.25), &f); err != nil {
+		t.Fatalf("Scan(Float) from numeric error = %v", err)
+	}
+	if f != generic.MustFloat(1.25) {
+		t.Errorf("Scan(Float) from numeric = %v, want 1.25", f)
+	}
+
+	var b generic.Bool
+	if err := m.Scan(pgtype.BoolOID, pgtype.BinaryFormatCode, encode(pgtype.BoolOID, true), &b); err != nil {
+		t.Fatalf("Scan(Bool) error = %v", err)
+	}
+	if !b.Bool() {
+		t.Errorf("Scan(Bool) = %v, want true", b)
+	}
+
+	var s generic.String
+	if err := m.Scan(pgtype.TextOID, pgtype.BinaryFormatCode, encode(pgtype.TextOID, "foo"), &s); err != nil {
+		t.Fatalf("Scan(String) error = %v", err)
+	}
+	if s != generic.MustString("foo") {
+		t.Errorf("Scan(String) = %v, want foo", s)
+	}
+
+	var u generic.URL
+	if err := m.Scan(pgtype.TextOID, pgtype.BinaryFormatCode, encode(pgtype.TextOID, "https://example.com/a"), &u); err != nil {
+		t.Fatalf("Scan(URL) error = %v", err)
+	}
+	if u.String() != "https://example.com/a" {
+		t.Errorf("Scan(URL) = %v, want https://example.com/a", u)
+	}
+
+	var tm generic.Time
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, encode(pgtype.TimestamptzOID, ts), &tm); err != nil {
+		t.Fatalf("Scan(Time) error = %v", err)
+	}
+	if !tm.Time().Equal(ts) {
+		t.Errorf("Scan(Time) = %v, want %v", tm, ts)
+	}
+
+	var tms generic.TimestampMS
+	if err := m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, encode(pgtype.Int8OID, int64(1595620800000)), &tms); err != nil {
+		t.Fatalf("Scan(TimestampMS) error = %v", err)
+	}
+	if !tms.Time().Equal(ts) {
+		t.Errorf("Scan(TimestampMS) = %v, want %v", tms.Time(), ts)
+	}
+
+	var ui generic.Uint
+	if err := m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, encode(pgtype.Int8OID, int64(-1)), &ui); err == nil {
+		t.Error("Scan(Uint) expected error for negative value")
+	}
+}
+
+func TestScanNull(t *testing.T) {
+	m := newMap()
+	i := generic.MustInt(1)
+	if err := m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, nil, &i); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if i.Valid() {
+		t.Errorf("Scan() = %v, want invalid", i)
+	}
+	tm := generic.MustTime(time.Now())
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, nil, &tm); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if tm.Valid() {
+		t.Errorf("Scan() = %v, want invalid", tm)
+	}
+}
+
+func TestScanInfinity(t *testing.T) {
+	m := newMap()
+	buf, err := m.Encode(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true}, nil)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	var tm generic.Time
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, buf, &tm); err == nil {
+		t.Error("Scan() expected error for infinity")
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	m := newMap()
+	n := len(m.TryWrapEncodePlanFuncs)
+	Register(m)
+	if got := len(m.TryWrapEncodePlanFuncs); got != n {
+		t.Errorf("len(TryWrapEncodePlanFuncs) = %d after second Register, want %d", got, n)
+	}
+	var i generic.Int
+	if err := m.Scan(pgtype.Int8OID, pgtype.TextFormatCode, []byte("5"), &i); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if i != generic.MustInt(5) {
+		t.Errorf("Scan() = %v, want 5", i)
+	}
+}
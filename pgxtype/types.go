@@ -0,0 +1,235 @@
+package pgxtype
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/usk81/generic/v2"
+)
+
+type boolWrapper generic.Bool
+
+func (w *boolWrapper) ScanBool(v pgtype.Bool) error {
+	return scan((*generic.Bool)(w), v.Bool, v.Valid)
+}
+
+func (w boolWrapper) BoolValue() (pgtype.Bool, error) {
+	v := generic.Bool(w)
+	return pgtype.Bool{Bool: v.Bool(), Valid: v.Valid()}, nil
+}
+
+type floatWrapper generic.Float
+
+func (w *floatWrapper) ScanFloat64(v pgtype.Float8) error {
+	return scan((*generic.Float)(w), v.Float64, v.Valid)
+}
+
+func (w floatWrapper) Float64Value() (pgtype.Float8, error) {
+	v := generic.Float(w)
+	return pgtype.Float8{Float64: v.Float64(), Valid: v.Valid()}, nil
+}
+
+type intWrapper generic.Int
+
+func (w *intWrapper) ScanInt64(v pgtype.Int8) error {
+	return scan((*generic.Int)(w), v.Int64, v.Valid)
+}
+
+func (w intWrapper) Int64Value() (pgtype.Int8, error) {
+	v := generic.Int(w)
+	return pgtype.Int8{Int64: v.Int64(), Valid: v.Valid()}, nil
+}
+
+type uintWrapper generic.Uint
+
+func (w *uintWrapper) ScanInt64(v pgtype.Int8) error {
+	return scan((*generic.Uint)(w), v.Int64, v.Valid)
+}
+
+func (w uintWrapper) Int64Value() (pgtype.Int8, error) {
+	v := generic.Uint(w)
+	if v.Uint64() > math.MaxInt64 {
+		return pgtype.Int8{}, generic.ErrOverflow
+	}
+	return pgtype.Int8{Int64: int64(v.Uint64()), Valid: v.Valid()}, nil
+}
+
+type stringWrapper generic.String
+
+func (w *stringWrapper) ScanText(v pgtype.Text) error {
+	return scan((*generic.String)(w), v.String, v.Valid)
+}
+
+func (w stringWrapper) TextValue() (pgtype.Text, error) {
+	v := generic.String(w)
+	return pgtype.Text{String: v.String(), Valid: v.Valid()}, nil
+}
+
+type urlWrapper generic.URL
+
+func (w *urlWrapper) ScanText(v pgtype.Text) error {
+	return scan((*generic.URL)(w), v.String, v.Valid)
+}
+
+func (w urlWrapper) TextValue() (pgtype.Text, error) {
+	v := generic.URL(w)
+	return pgtype.Text{String: v.String(), Valid: v.Valid()}, nil
+}
+
+type timeWrapper generic.Time
+
+func (w *timeWrapper) ScanTimestamptz(v pgtype.Timestamptz) error {
+	return scanTime((*generic.Time)(w), v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (w *timeWrapper) ScanTimestamp(v pgtype.Timestamp) error {
+	return scanTime((*generic.Time)(w), v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (w timeWrapper) TimestamptzValue() (pgtype.Timestamptz, error) {
+	v := generic.Time(w)
+	return pgtype.Timestamptz{Time: v.Time(), Valid: v.Valid()}, nil
+}
+
+func (w timeWrapper) TimestampValue() (pgtype.Timestamp, error) {
+	v := generic.Time(w)
+	return pgtype.Timestamp{Time: v.Time(), Valid: v.Valid()}, nil
+}
+
+// The timestamp types are also stored as integer epochs, so their wrappers implement
+// the Int64 interfaces using the unit of each type in addition to the timestamp ones.
+
+type timestampWrapper generic.Timestamp
+
+func (w *timestampWrapper) ScanTimestamptz(v pgtype.Timestamptz) error {
+	return scanTime((*generic.Timestamp)(w), v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (w *timestampWrapper) ScanTimestamp(v pgtype.Timestamp) error {
+	return scanTime((*generic.Timestamp)(w), v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (w *timestampWrapper) ScanInt64(v pgtype.Int8) error {
+	return scan((*generic.Timestamp)(w), v.Int64, v.Valid)
+}
+
+func (w timestampWrapper) TimestamptzValue() (pgtype.Timestamptz, error) {
+	v := generic.Timestamp(w)
+	return pgtype.Timestamptz{Time: v.Time(), Valid: v.Valid()}, nil
+}
+
+func (w timestampWrapper) TimestampValue() (pgtype.Timestamp, error) {
+	v := generic.Timestamp(w)
+	return pgtype.Timestamp{Time: v.Time(), Valid: v.Valid()}, nil
+}
+
+func (w timestampWrapper) Int64Value() (pgtype.Int8, error) {
+	v := generic.Timestamp(w)
+	return pgtype.Int8{Int64: v.Int64(), Valid: v.Valid()}, nil
+}
+
+type timestampMSWrapper generic.TimestampMS
+
+func (w *timestampMSWrapper) ScanTimestamptz(v pgtype.Timestamptz) error {
+	return scanTime((*generic.TimestampMS)(w), v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (w *timestampMSWrapper) ScanTimestamp(v pgtype.Timestamp) error {
+	return scanTime((*generic.TimestampMS)(w), v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (w *timestampMSWrapper) ScanInt64(v pgtype.Int8) error {
+	return scan((*generic.TimestampMS)(w), v.Int64, v.Valid)
+}
+
+func (w timestampMSWrapper) TimestamptzValue() (pgtype.Timestamptz, error) {
+	v := generic.TimestampMS(w)
+	return pgtype.Timestamptz{Time: v.Time(), Valid: v.Valid()}, nil
+}
+
+func (w timestampMSWrapper) TimestampValue() (pgtype.Timestamp, error) {
+	v := generic.TimestampMS(w)
+	return pgtype.Timestamp{Time: v.Time(), Valid: v.Valid()}, nil
+}
+
+func (w timestampMSWrapper) Int64Value() (pgtype.Int8, error) {
+	v := generic.TimestampMS(w)
+	return pgtype.Int8{Int64: v.Int64(), Valid: v.Valid()}, nil
+}
+
+type timestampMicroWrapper generic.TimestampMicro
+
+func (w *timestampMicroWrapper) ScanTimestamptz(v pgtype.Timestamptz) error {
+	return scanTime((*generic.TimestampMicro)(w), v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (w *timestampMicroWrapper) ScanTimestamp(v pgtype.Timestamp) error {
+	return scanTime((*generic.TimestampMicro)(w), v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (w *timestampMicroWrapper) ScanInt64(v pgtype.Int8) error {
+	return scan((*generic.TimestampMicro)(w), v.Int64, v.Valid)
+}
+
+func (w timestampMicroWrapper) TimestamptzValue() (pgtype.Timestamptz, error) {
+	v := generic.TimestampMicro(w)
+	return pgtype.Timestamptz{Time: v.Time(), Valid: v.Valid()}, nil
+}
+
+func (w timestampMicroWrapper) TimestampValue() (pgtype.Timestamp, error) {
+	v := generic.TimestampMicro(w)
+	return pgtype.Timestamp{Time: v.Time(), Valid: v.Valid()}, nil
+}
+
+func (w timestampMicroWrapper) Int64Value() (pgtype.Int8, error) {
+	v := generic.TimestampMicro(w)
+	return pgtype.Int8{Int64: v.Int64(), Valid: v.Valid()}, nil
+}
+
+type timestampNanoWrapper generic.TimestampNano
+
+func (w *timestampNanoWrapper) ScanTimestamptz(v pgtype.Timestamptz) error {
+	return scanTime((*generic.TimestampNano)(w), v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (w *timestampNanoWrapper) ScanTimestamp(v pgtype.Timestamp) error {
+	return scanTime((*generic.TimestampNano)(w), v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (w *timestampNanoWrapper) ScanInt64(v pgtype.Int8) error {
+	return scan((*generic.TimestampNano)(w), v.Int64, v.Valid)
+}
+
+func (w timestampNanoWrapper) TimestamptzValue() (pgtype.Timestamptz, error) {
+	v := generic.TimestampNano(w)
+	return pgtype.Timestamptz{Time: v.Time(), Valid: v.Valid()}, nil
+}
+
+func (w timestampNanoWrapper) TimestampValue() (pgtype.Timestamp, error) {
+	v := generic.TimestampNano(w)
+	return pgtype.Timestamp{Time: v.Time(), Valid: v.Valid()}, nil
+}
+
+func (w timestampNanoWrapper) Int64Value() (pgtype.Int8, error) {
+	v := generic.TimestampNano(w)
+	return pgtype.Int8{Int64: v.Int64(), Valid: v.Valid()}, nil
+}
+
+// scan sets x to dst, or resets dst when the database value is NULL.
+func scan(dst generic.Type, x interface{}, valid bool) error {
+	if !valid {
+		dst.Reset()
+		return nil
+	}
+	return dst.Set(x)
+}
+
+// scanTime is scan for timestamp values, which cannot be infinite.
+func scanTime(dst generic.Type, t time.Time, inf pgtype.InfinityModifier, valid bool) error {
+	if valid && inf != pgtype.Finite {
+		return fmt.Errorf("cannot scan infinite timestamp into %T", dst)
+	}
+	return scan(dst, t, valid)
+}
@@ -0,0 +1,313 @@
+package generic
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrInvalidRangeLiteral is returned when a PostgreSQL range literal cannot be parsed
+	ErrInvalidRangeLiteral = errors.New("invalid range literal")
+
+	// ErrInvalidRangeBounds is returned when the lower bound of a range is greater than the upper bound,
+	// or when bounds other than "[]", "[)", "(]" and "()" are specified
+	ErrInvalidRangeBounds = errors.New("invalid range bounds")
+
+	// ErrRangeNotContiguous is returned when the union of two ranges would not be contiguous
+	ErrRangeNotContiguous = errors.New("range union would not be contiguous")
+)
+
+// rangeOf is the representation shared by the range types.
+// A side without a bound is unbounded, and is always exclusive.
+type rangeOf[T any] struct {
+	lower, upper       T
+	hasLower, hasUpper bool
+	lowerInc, upperInc bool
+	empty              bool
+}
+
+// newRange returns a range normalized by cmp, where bounds is one of "[]", "[)", "(]" and "()".
+func newRange[T any](lower T, hasLower bool, upper T, hasUpper bool, bounds string, cmp func(a, b T) int) (rangeOf[T], error) {
+	if len(bounds) != 2 || (bounds[0] != '[' && bounds[0] != '(') || (bounds[1] != ']' && bounds[1] != ')') {
+		return rangeOf[T]{}, ErrInvalidRangeBounds
+	}
+	r := rangeOf[T]{
+		lower:    lower,
+		upper:    upper,
+		hasLower: hasLower,
+		hasUpper: hasUpper,
+		lowerInc: bounds[0] == '[' && hasLower,
+		upperInc: bounds[1] == ']' && hasUpper,
+	}
+	if !hasLower || !hasUpper {
+		return r, nil
+	}
+	switch c := cmp(lower, upper); {
+	case c > 0:
+		return rangeOf[T]{}, ErrInvalidRangeBounds
+	case c == 0 && !(r.lowerInc && r.upperInc):
+		return rangeOf[T]{empty: true}, nil
+	}
+	return r, nil
+}
+
+// bounds returns the bounds of r such as "[)".
+func (r rangeOf[T]) bounds() string {
+	b := []byte("()")
+	if r.lowerInc {
+		b[0] = '['
+	}
+	if r.upperInc {
+		b[1] = ']'
+	}
+	return string(b)
+}
+
+func (r rangeOf[T]) contains(x T, cmp func(a, b T) int) bool {
+	if r.empty {
+		return false
+	}
+	if r.hasLower {
+		if c := cmp(r.lower, x); c > 0 || (c == 0 && !r.lowerInc) {
+			return false
+		}
+	}
+	if r.hasUpper {
+		if c := cmp(x, r.upper); c > 0 || (c == 0 && !r.upperInc) {
+			return false
+		}
+	}
+	return true
+}
+
+// lowerBefore reports whether the lower bound of r starts no later than the upper bound of x ends.
+func (r rangeOf[T]) lowerBefore(x rangeOf[T], cmp func(a, b T) int) bool {
+	if !r.hasLower || !x.hasUpper {
+		return true
+	}
+	c := cmp(r.lower, x.upper)
+	return c < 0 || (c == 0 && r.lowerInc && x.upperInc)
+}
+
+func (r rangeOf[T]) overlaps(x rangeOf[T], cmp func(a, b T) int) bool {
+	if r.empty || x.empty {
+		return false
+	}
+	return r.lowerBefore(x, cmp) && x.lowerBefore(r, cmp)
+}
+
+// adjacent reports whether r and x touch without overlapping.
+func (r rangeOf[T]) adjacent(x rangeOf[T], cmp func(a, b T) int) bool {
+	touch := func(a, b rangeOf[T]) bool {
+		return a.hasUpper && b.hasLower && cmp(a.upper, b.lower) == 0 && a.upperInc != b.lowerInc
+	}
+	return !r.empty && !x.empty && (touch(r, x) || touch(x, r))
+}
+
+// compareLower orders lower bounds, where unbounded is the lowest and inclusive is lower than exclusive.
+func (r rangeOf[T]) compareLower(x rangeOf[T], cmp func(a, b T) int) int {
+	switch {
+	case !r.hasLower && !x.hasLower:
+		return 0
+	case !r.hasLower:
+		return -1
+	case !x.hasLower:
+		return 1
+	}
+	if c := cmp(r.lower, x.lower); c != 0 {
+		return c
+	}
+	switch {
+	case r.lowerInc == x.lowerInc:
+		return 0
+	case r.lowerInc:
+		return -1
+	}
+	return 1
+}
+
+// compareUpper orders upper bounds, where unbounded is the highest and exclusive is lower than inclusive.
+func (r rangeOf[T]) compareUpper(x rangeOf[T], cmp func(a, b T) int) int {
+	switch {
+	case !r.hasUpper && !x.hasUpper:
+		return 0
+	case !r.hasUpper:
+		return 1
+	case !x.hasUpper:
+		return -1
+	}
+	if c := cmp(r.upper, x.upper); c != 0 {
+		return c
+	}
+	switch {
+	case r.upperInc == x.upperInc:
+		return 0
+	case r.upperInc:
+		return 1
+	}
+	return -1
+}
+
+func (r rangeOf[T]) intersect(x rangeOf[T], cmp func(a, b T) int) rangeOf[T] {
+	if !r.overlaps(x, cmp) {
+		return rangeOf[T]{empty: true}
+	}
+	result := r
+	if r.compareLower(x, cmp) < 0 {
+		result.lower, result.hasLower, result.lowerInc = x.lower, x.hasLower, x.lowerInc
+	}
+	if r.compareUpper(x, cmp) > 0 {
+		result.upper, result.hasUpper, result.upperInc = x.upper, x.hasUpper, x.upperInc
+	}
+	return result
+}
+
+func (r rangeOf[T]) union(x rangeOf[T], cmp func(a, b T) int) (rangeOf[T], error) {
+	switch {
+	case r.empty:
+		return x, nil
+	case x.empty:
+		return r, nil
+	case !r.overlaps(x, cmp) && !r.adjacent(x, cmp):
+		return rangeOf[T]{}, ErrRangeNotContiguous
+	}
+	result := r
+	if r.compareLower(x, cmp) > 0 {
+		result.lower, result.hasLower, result.lowerInc = x.lower, x.hasLower, x.lowerInc
+	}
+	if r.compareUpper(x, cmp) < 0 {
+		result.upper, result.hasUpper, result.upperInc = x.upper, x.hasUpper, x.upperInc
+	}
+	return result, nil
+}
+
+func (r rangeOf[T]) equal(x rangeOf[T], cmp func(a, b T) int) bool {
+	if r.empty || x.empty {
+		return r.empty == x.empty
+	}
+	return r.compareLower(x, cmp) == 0 && r.compareUpper(x, cmp) == 0
+}
+
+// rangeLiteral is a parsed PostgreSQL range literal such as `[1,10)`.
+type rangeLiteral struct {
+	lower, upper       string
+	hasLower, hasUpper bool
+	bounds             string
+	empty              bool
+}
+
+// parseRangeLiteral parses a PostgreSQL range literal.
+// An empty unquoted bound is unbounded, while `""` is an empty string.
+func parseRangeLiteral(s string) (l rangeLiteral, err error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "empty") {
+		return rangeLiteral{empty: true}, nil
+	}
+	if len(s) < 3 || (s[0] != '[' && s[0] != '(') || (s[len(s)-1] != ']' && s[len(s)-1] != ')') {
+		return l, ErrInvalidRangeLiteral
+	}
+	l.bounds = string([]byte{s[0], s[len(s)-1]})
+	body := s[1 : len(s)-1]
+	var rest string
+	if l.lower, l.hasLower, rest, err = parseRangeBound(body); err != nil {
+		return l, err
+	}
+	if rest == "" || rest[0] != ',' {
+		return l, ErrInvalidRangeLiteral
+	}
+	if l.upper, l.hasUpper, rest, err = parseRangeBound(rest[1:]); err != nil {
+		return l, err
+	}
+	if rest != "" {
+		return l, ErrInvalidRangeLiteral
+	}
+	return l, nil
+}
+
+// parseNumericRangeLiteral parses a PostgreSQL range literal or a range such as "1..10", whose sides are inclusive.
+// A side of "1..10" may be omitted to leave it unbounded, as in "..10".
+func parseNumericRangeLiteral(s string) (rangeLiteral, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s[0] == '[' || s[0] == '(' || strings.EqualFold(s, "empty") {
+		return parseRangeLiteral(s)
+	}
+	i := strings.Index(s, "..")
+	if i < 0 {
+		return rangeLiteral{}, ErrInvalidRangeLiteral
+	}
+	l := rangeLiteral{
+		lower:  strings.TrimSpace(s[:i]),
+		upper:  strings.TrimSpace(s[i+2:]),
+		bounds: "[]",
+	}
+	l.hasLower, l.hasUpper = l.lower != "", l.upper != ""
+	return l, nil
+}
+
+// parseRangeBound reads a bound up to the next unquoted comma or the end of s.
+func parseRangeBound(s string) (bound string, ok bool, rest string, err error) {
+	buf := bytes.Buffer{}
+	quoted := false
+	i := 0
+	for ; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			if i+1 >= len(s) {
+				return "", false, "", ErrInvalidRangeLiteral
+			}
+			i++
+			buf.WriteByte(s[i])
+		case c == '"':
+			if quoted && i+1 < len(s) && s[i+1] == '"' {
+				buf.WriteByte('"')
+				i++
+				continue
+			}
+			quoted = !quoted
+			ok = true
+		case c == ',' && !quoted:
+			return buf.String(), ok || buf.Len() > 0, s[i:], nil
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	if quoted {
+		return "", false, "", ErrInvalidRangeLiteral
+	}
+	return buf.String(), ok || buf.Len() > 0, s[i:], nil
+}
+
+// formatRangeLiteral formats a PostgreSQL range literal.
+func formatRangeLiteral(l rangeLiteral) string {
+	if l.empty {
+		return "empty"
+	}
+	buf := bytes.Buffer{}
+	buf.WriteByte(l.bounds[0])
+	if l.hasLower {
+		writeRangeBound(&buf, l.lower)
+	}
+	buf.WriteByte(',')
+	if l.hasUpper {
+		writeRangeBound(&buf, l.upper)
+	}
+	buf.WriteByte(l.bounds[1])
+	return buf.String()
+}
+
+func writeRangeBound(buf *bytes.Buffer, s string) {
+	if s != "" && !strings.ContainsAny(s, ",()[]\"\\ \t\n\r\v\f") {
+		buf.WriteString(s)
+		return
+	}
+	buf.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			buf.WriteByte('\\')
+		}
+		buf.WriteByte(s[i])
+	}
+	buf.WriteByte('"')
+}
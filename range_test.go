@@ -0,0 +1,103 @@
+package generic
+
+import (
+	"testing"
+)
+
+func TestParseRangeLiteral(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    rangeLiteral
+		wantErr bool
+	}{
+		{s: "[1,10)", want: rangeLiteral{lower: "1", upper: "10", hasLower: true, hasUpper: true, bounds: "[)"}},
+		{s: " (,5] ", want: rangeLiteral{upper: "5", hasUpper: true, bounds: "(]"}},
+		{s: "(,)", want: rangeLiteral{bounds: "()"}},
+		{s: "EMPTY", want: rangeLiteral{empty: true}},
+		{s: `["2024-01-02 00:00:00+00","a\"b""c")`, want: rangeLiteral{lower: "2024-01-02 00:00:00+00", upper: `a"b"c`, hasLower: true, hasUpper: true, bounds: "[)"}},
+		{s: `["",x]`, want: rangeLiteral{lower: "", upper: "x", hasLower: true, hasUpper: true, bounds: "[]"}},
+		{s: "[1,2", wantErr: true},
+		{s: "[1]", wantErr: true},
+		{s: "[1,2,3]", wantErr: true},
+		{s: `["1,2]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := parseRangeLiteral(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRangeLiteral() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseRangeLiteral() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRangeLiteral(t *testing.T) {
+	tests := []struct {
+		l    rangeLiteral
+		want string
+	}{
+		{l: rangeLiteral{lower: "1", upper: "10", hasLower: true, hasUpper: true, bounds: "[)"}, want: "[1,10)"},
+		{l: rangeLiteral{upper: "5", hasUpper: true, bounds: "(]"}, want: "(,5]"},
+		{l: rangeLiteral{lower: `a "b"`, hasLower: true, bounds: "[)"}, want: `["a \"b\"",)`},
+		{l: rangeLiteral{lower: "", hasLower: true, bounds: "[)"}, want: `["",)`},
+		{l: rangeLiteral{empty: true}, want: "empty"},
+	}
+	for _, tt := range tests {
+		if got := formatRangeLiteral(tt.l); got != tt.want {
+			t.Errorf("formatRangeLiteral() = %s, want %s", got, tt.want)
+		}
+		if got, err := parseRangeLiteral(formatRangeLiteral(tt.l)); err != nil || got != tt.l {
+			t.Errorf("parseRangeLiteral(formatRangeLiteral()) = %+v, %v, want %+v", got, err, tt.l)
+		}
+	}
+}
+
+func TestNewRange(t *testing.T) {
+	cmp := func(a, b int) int { return a - b }
+	if _, err := newRange(2, true, 1, true, "[)", cmp); err != ErrInvalidRangeBounds {
+		t.Errorf("newRange() error = %v, want ErrInvalidRangeBounds", err)
+	}
+	if _, err := newRange(1, true, 2, true, "[[", cmp); err != ErrInvalidRangeBounds {
+		t.Errorf("newRange() error = %v, want ErrInvalidRangeBounds", err)
+	}
+	if r, _ := newRange(1, true, 1, true, "[)", cmp); !r.empty {
+		t.Error("newRange() of [1,1) should be empty")
+	}
+	if r, _ := newRange(1, true, 1, true, "[]", cmp); r.empty || !r.contains(1, cmp) {
+		t.Error("newRange() of [1,1] should contain 1")
+	}
+	if r, _ := newRange(0, false, 1, true, "[]", cmp); r.bounds() != "(]" {
+		t.Errorf("newRange() bounds = %s, want (]", r.bounds())
+	}
+}
+
+func TestParseNumericRangeLiteral(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    rangeLiteral
+		wantErr bool
+	}{
+		{s: "[1,10)", want: rangeLiteral{lower: "1", upper: "10", hasLower: true, hasUpper: true, bounds: "[)"}},
+		{s: "1..10", want: rangeLiteral{lower: "1", upper: "10", hasLower: true, hasUpper: true, bounds: "[]"}},
+		{s: " -1.5 .. 2 ", want: rangeLiteral{lower: "-1.5", upper: "2", hasLower: true, hasUpper: true, bounds: "[]"}},
+		{s: "..10", want: rangeLiteral{upper: "10", hasUpper: true, bounds: "[]"}},
+		{s: "1..", want: rangeLiteral{lower: "1", hasLower: true, bounds: "[]"}},
+		{s: "empty", want: rangeLiteral{empty: true}},
+		{s: "10", wantErr: true},
+		{s: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := parseNumericRangeLiteral(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseNumericRangeLiteral() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseNumericRangeLiteral() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
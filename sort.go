@@ -0,0 +1,253 @@
+package generic
+
+// BoolSlice attaches the methods of sort.Interface to []Bool, sorting in increasing order with invalid values first.
+type BoolSlice []Bool
+
+func (s BoolSlice) Len() int           { return len(s) }
+func (s BoolSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s BoolSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// BoolSortFunc returns a comparison function for []Bool that places invalid values according to o.
+func BoolSortFunc(o NullOrder) func(a, b Bool) int {
+	return func(a, b Bool) int {
+		return a.Compare(b, o)
+	}
+}
+
+// FloatSlice attaches the methods of sort.Interface to []Float, sorting in increasing order with invalid values first.
+type FloatSlice []Float
+
+func (s FloatSlice) Len() int           { return len(s) }
+func (s FloatSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s FloatSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// FloatSortFunc returns a comparison function for []Float that places invalid values according to o.
+func FloatSortFunc(o NullOrder) func(a, b Float) int {
+	return func(a, b Float) int {
+		return a.Compare(b, o)
+	}
+}
+
+// IntSlice attaches the methods of sort.Interface to []Int, sorting in increasing order with invalid values first.
+type IntSlice []Int
+
+func (s IntSlice) Len() int           { return len(s) }
+func (s IntSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s IntSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// IntSortFunc returns a comparison function for []Int that places invalid values according to o.
+func IntSortFunc(o NullOrder) func(a, b Int) int {
+	return func(a, b Int) int {
+		return a.Compare(b, o)
+	}
+}
+
+// StringSlice attaches the methods of sort.Interface to []String, sorting in increasing order with invalid values first.
+type StringSlice []String
+
+func (s StringSlice) Len() int           { return len(s) }
+func (s StringSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s StringSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// StringSortFunc returns a comparison function for []String that places invalid values according to o.
+func StringSortFunc(o NullOrder) func(a, b String) int {
+	return func(a, b String) int {
+		return a.Compare(b, o)
+	}
+}
+
+// TimeSlice attaches the methods of sort.Interface to []Time, sorting in increasing order with invalid values first.
+type TimeSlice []Time
+
+func (s TimeSlice) Len() int           { return len(s) }
+func (s TimeSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s TimeSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// TimeSortFunc returns a comparison function for []Time that places invalid values according to o.
+func TimeSortFunc(o NullOrder) func(a, b Time) int {
+	return func(a, b Time) int {
+		return a.Compare(b, o)
+	}
+}
+
+// TimestampSlice attaches the methods of sort.Interface to []Timestamp, sorting in increasing order with invalid values first.
+type TimestampSlice []Timestamp
+
+func (s TimestampSlice) Len() int           { return len(s) }
+func (s TimestampSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s TimestampSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// TimestampSortFunc returns a comparison function for []Timestamp that places invalid values according to o.
+func TimestampSortFunc(o NullOrder) func(a, b Timestamp) int {
+	return func(a, b Timestamp) int {
+		return a.Compare(b, o)
+	}
+}
+
+// TimestampMSSlice attaches the methods of sort.Interface to []TimestampMS, sorting in increasing order with invalid values first.
+type TimestampMSSlice []TimestampMS
+
+func (s TimestampMSSlice) Len() int           { return len(s) }
+func (s TimestampMSSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s TimestampMSSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// TimestampMSSortFunc returns a comparison function for []TimestampMS that places invalid values according to o.
+func TimestampMSSortFunc(o NullOrder) func(a, b TimestampMS) int {
+	return func(a, b TimestampMS) int {
+		return a.Compare(b, o)
+	}
+}
+
+// TimestampMicroSlice attaches the methods of sort.Interface to []TimestampMicro, sorting in increasing order with invalid values first.
+type TimestampMicroSlice []TimestampMicro
+
+func (s TimestampMicroSlice) Len() int           { return len(s) }
+func (s TimestampMicroSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s TimestampMicroSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// TimestampMicroSortFunc returns a comparison function for []TimestampMicro that places invalid values according to o.
+func TimestampMicroSortFunc(o NullOrder) func(a, b TimestampMicro) int {
+	return func(a, b TimestampMicro) int {
+		return a.Compare(b, o)
+	}
+}
+
+// TimestampNanoSlice attaches the methods of sort.Interface to []TimestampNano, sorting in increasing order with invalid values first.
+type TimestampNanoSlice []TimestampNano
+
+func (s TimestampNanoSlice) Len() int           { return len(s) }
+func (s TimestampNanoSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s TimestampNanoSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// TimestampNanoSortFunc returns a comparison function for []TimestampNano that places invalid values according to o.
+func TimestampNanoSortFunc(o NullOrder) func(a, b TimestampNano) int {
+	return func(a, b TimestampNano) int {
+		return a.Compare(b, o)
+	}
+}
+
+// UintSlice attaches the methods of sort.Interface to []Uint, sorting in increasing order with invalid values first.
+type UintSlice []Uint
+
+func (s UintSlice) Len() int           { return len(s) }
+func (s UintSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s UintSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// UintSortFunc returns a comparison function for []Uint that places invalid values according to o.
+func UintSortFunc(o NullOrder) func(a, b Uint) int {
+	return func(a, b Uint) int {
+		return a.Compare(b, o)
+	}
+}
+
+// URLSlice attaches the methods of sort.Interface to []URL, sorting in increasing order with invalid values first.
+type URLSlice []URL
+
+func (s URLSlice) Len() int           { return len(s) }
+func (s URLSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s URLSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// URLSortFunc returns a comparison function for []URL that places invalid values according to o.
+func URLSortFunc(o NullOrder) func(a, b URL) int {
+	return func(a, b URL) int {
+		return a.Compare(b, o)
+	}
+}
+
+// UUIDSlice attaches the methods of sort.Interface to []UUID, sorting in increasing order with invalid values first.
+type UUIDSlice []UUID
+
+func (s UUIDSlice) Len() int           { return len(s) }
+func (s UUIDSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s UUIDSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// UUIDSortFunc returns a comparison function for []UUID that places invalid values according to o.
+func UUIDSortFunc(o NullOrder) func(a, b UUID) int {
+	return func(a, b UUID) int {
+		return a.Compare(b, o)
+	}
+}
+
+// IPSlice attaches the methods of sort.Interface to []IP, sorting in increasing order with invalid values first.
+type IPSlice []IP
+
+func (s IPSlice) Len() int           { return len(s) }
+func (s IPSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s IPSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// IPSortFunc returns a comparison function for []IP that places invalid values according to o.
+func IPSortFunc(o NullOrder) func(a, b IP) int {
+	return func(a, b IP) int {
+		return a.Compare(b, o)
+	}
+}
+
+// PrefixSlice attaches the methods of sort.Interface to []Prefix, sorting in increasing order with invalid values first.
+type PrefixSlice []Prefix
+
+func (s PrefixSlice) Len() int           { return len(s) }
+func (s PrefixSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s PrefixSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// PrefixSortFunc returns a comparison function for []Prefix that places invalid values according to o.
+func PrefixSortFunc(o NullOrder) func(a, b Prefix) int {
+	return func(a, b Prefix) int {
+		return a.Compare(b, o)
+	}
+}
+
+// EmailSlice attaches the methods of sort.Interface to []Email, sorting in increasing order with invalid values first.
+type EmailSlice []Email
+
+func (s EmailSlice) Len() int           { return len(s) }
+func (s EmailSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s EmailSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// EmailSortFunc returns a comparison function for []Email that places invalid values according to o.
+func EmailSortFunc(o NullOrder) func(a, b Email) int {
+	return func(a, b Email) int {
+		return a.Compare(b, o)
+	}
+}
+
+// BytesSlice attaches the methods of sort.Interface to []Bytes, sorting in increasing order with invalid values first.
+type BytesSlice []Bytes
+
+func (s BytesSlice) Len() int           { return len(s) }
+func (s BytesSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s BytesSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// BytesSortFunc returns a comparison function for []Bytes that places invalid values according to o.
+func BytesSortFunc(o NullOrder) func(a, b Bytes) int {
+	return func(a, b Bytes) int {
+		return a.Compare(b, o)
+	}
+}
+
+// MoneySlice attaches the methods of sort.Interface to []Money, sorting in increasing order with invalid values first.
+type MoneySlice []Money
+
+func (s MoneySlice) Len() int           { return len(s) }
+func (s MoneySlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s MoneySlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// MoneySortFunc returns a comparison function for []Money that places invalid values according to o.
+func MoneySortFunc(o NullOrder) func(a, b Money) int {
+	return func(a, b Money) int {
+		return a.Compare(b, o)
+	}
+}
+
+// ZonedTimeSlice attaches the methods of sort.Interface to []ZonedTime, sorting in increasing order with invalid values first.
+type ZonedTimeSlice []ZonedTime
+
+func (s ZonedTimeSlice) Len() int           { return len(s) }
+func (s ZonedTimeSlice) Less(i, j int) bool { return s[i].Compare(s[j], NullsFirst) < 0 }
+func (s ZonedTimeSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// ZonedTimeSortFunc returns a comparison function for []ZonedTime that places invalid values according to o.
+func ZonedTimeSortFunc(o NullOrder) func(a, b ZonedTime) int {
+	return func(a, b ZonedTime) int {
+		return a.Compare(b, o)
+	}
+}
@@ -0,0 +1,51 @@
+package generic
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestIntSlice(t *testing.T) {
+	s := []Int{MustInt(3), {}, MustInt(-1), MustInt(2)}
+	sort.Sort(IntSlice(s))
+	want := []Int{{}, MustInt(-1), MustInt(2), MustInt(3)}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("sort.Sort(IntSlice) = %v, want %v", s, want)
+	}
+}
+
+func TestIntSortFunc(t *testing.T) {
+	s := []Int{MustInt(3), {}, MustInt(-1)}
+	f := IntSortFunc(NullsLast)
+	sort.Slice(s, func(i, j int) bool {
+		return f(s[i], s[j]) < 0
+	})
+	want := []Int{MustInt(-1), MustInt(3), {}}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("IntSortFunc(NullsLast) sorted = %v, want %v", s, want)
+	}
+}
+
+func TestStringSlice(t *testing.T) {
+	s := []String{MustString("b"), {}, MustString("a")}
+	sort.Sort(StringSlice(s))
+	want := []String{{}, MustString("a"), MustString("b")}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("sort.Sort(StringSlice) = %v, want %v", s, want)
+	}
+}
+
+func TestTimeSortFunc(t *testing.T) {
+	earlier := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+	s := []Time{MustTime(later), {}, MustTime(earlier)}
+	f := TimeSortFunc(NullsFirst)
+	sort.Slice(s, func(i, j int) bool {
+		return f(s[i], s[j]) < 0
+	})
+	if s[0].Valid() || !s[1].Time().Equal(earlier) || !s[2].Time().Equal(later) {
+		t.Errorf("TimeSortFunc(NullsFirst) sorted = %v", s)
+	}
+}
@@ -0,0 +1,59 @@
+package generic
+
+import (
+	"strings"
+	"time"
+)
+
+// TimeParseOptions configures how strings are parsed into Time
+type TimeParseOptions struct {
+	// Layouts are tried in order until one of them matches.
+	Layouts []string
+
+	// Location is used for inputs without a zone. If nil, UTC is used.
+	Location *time.Location
+}
+
+// DefaultTimeParseOptions is used by Time.Scan and Time.Set when x is a string or []byte
+var DefaultTimeParseOptions = TimeParseOptions{
+	Layouts: []string{
+		time.RFC3339Nano,
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999Z07",
+		"2006-01-02 15:04:05.999999999",
+		time.RFC1123Z,
+		time.RFC1123,
+		"2006-01-02",
+	},
+	Location: time.UTC,
+}
+
+// RegisterTimeLayout appends layouts to DefaultTimeParseOptions.Layouts.
+// It is not safe for concurrent use, so it should be called during initialization.
+func RegisterTimeLayout(layouts ...string) {
+	DefaultTimeParseOptions.Layouts = append(DefaultTimeParseOptions.Layouts, layouts...)
+}
+
+// ParseTime parses s with the layouts of o
+func ParseTime(s string, o TimeParseOptions) (Time, error) {
+	t, err := parseTimeString(s, o)
+	if err != nil {
+		return Time{}, err
+	}
+	return Time{ValidFlag: true, time: t}, nil
+}
+
+func parseTimeString(s string, o TimeParseOptions) (time.Time, error) {
+	loc := o.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	s = strings.TrimSpace(s)
+	for _, layout := range o.Layouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, ErrInvalidGenericValue{Value: s}
+}
@@ -0,0 +1,823 @@
+package generic
+
+import (
+	"database/sql/driver"
+	"math"
+	"strconv"
+	"time"
+)
+
+// BoolArray is generic PostgreSQL array type structure of Bool.
+// Elements are kept in row-major order together with the length of each dimension.
+type BoolArray struct {
+	ValidFlag
+	elems []Bool
+	dims  []int
+}
+
+// MarshalBoolArray return generic.BoolArray converting of request data
+func MarshalBoolArray(x interface{}) (BoolArray, error) {
+	v := BoolArray{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustBoolArray return generic.BoolArray converting of request data
+func MustBoolArray(x interface{}) BoolArray {
+	v, err := MarshalBoolArray(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a PostgreSQL array literal.
+func (v BoolArray) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a PostgreSQL array literal as string or []byte, or a slice of values convertible to Bool.
+func (v *BoolArray) Scan(x interface{}) (err error) {
+	var elems []Bool
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]Bool, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = scanArray(x, alloc, scanArrayElement)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// Weak returns the elements, but if BoolArray.ValidFlag is false, returns nil.
+func (v BoolArray) Weak() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Set sets a specified value.
+func (v *BoolArray) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Elements returns the elements in row-major order
+func (v BoolArray) Elements() []Bool {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Dims returns the length of each dimension
+func (v BoolArray) Dims() []int {
+	if !v.Valid() {
+		return nil
+	}
+	return append([]int(nil), v.dims...)
+}
+
+// Len returns the number of elements
+func (v BoolArray) Len() int {
+	if !v.Valid() {
+		return 0
+	}
+	return len(v.elems)
+}
+
+// String implements the Stringer interface.
+// It returns the PostgreSQL array literal, or an empty string if BoolArray.ValidFlag is false.
+func (v BoolArray) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return formatArrayLiteral(v.dims, len(v.elems), func(i int) (string, bool) {
+		if !v.elems[i].Valid() {
+			return "", false
+		}
+		if v.elems[i].Bool() {
+			return "t", true
+		}
+		return "f", true
+	})
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v BoolArray) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return marshalArrayJSON(v.dims, len(v.elems), func(i int) ([]byte, error) {
+		return v.elems[i].MarshalJSON()
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *BoolArray) UnmarshalJSON(data []byte) (err error) {
+	var elems []Bool
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]Bool, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = unmarshalArrayJSON(data, alloc)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// FloatArray is generic PostgreSQL array type structure of Float.
+// Elements are kept in row-major order together with the length of each dimension.
+type FloatArray struct {
+	ValidFlag
+	elems []Float
+	dims  []int
+}
+
+// MarshalFloatArray return generic.FloatArray converting of request data
+func MarshalFloatArray(x interface{}) (FloatArray, error) {
+	v := FloatArray{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustFloatArray return generic.FloatArray converting of request data
+func MustFloatArray(x interface{}) FloatArray {
+	v, err := MarshalFloatArray(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a PostgreSQL array literal.
+func (v FloatArray) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a PostgreSQL array literal as string or []byte, or a slice of values convertible to Float.
+func (v *FloatArray) Scan(x interface{}) (err error) {
+	var elems []Float
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]Float, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = scanArray(x, alloc, scanArrayElement)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// Weak returns the elements, but if FloatArray.ValidFlag is false, returns nil.
+func (v FloatArray) Weak() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Set sets a specified value.
+func (v *FloatArray) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Elements returns the elements in row-major order
+func (v FloatArray) Elements() []Float {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Dims returns the length of each dimension
+func (v FloatArray) Dims() []int {
+	if !v.Valid() {
+		return nil
+	}
+	return append([]int(nil), v.dims...)
+}
+
+// Len returns the number of elements
+func (v FloatArray) Len() int {
+	if !v.Valid() {
+		return 0
+	}
+	return len(v.elems)
+}
+
+// String implements the Stringer interface.
+// It returns the PostgreSQL array literal, or an empty string if FloatArray.ValidFlag is false.
+func (v FloatArray) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return formatArrayLiteral(v.dims, len(v.elems), func(i int) (string, bool) {
+		if !v.elems[i].Valid() {
+			return "", false
+		}
+		return floatLiteral(v.elems[i].Float64()), true
+	})
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v FloatArray) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return marshalArrayJSON(v.dims, len(v.elems), func(i int) ([]byte, error) {
+		return v.elems[i].MarshalJSON()
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *FloatArray) UnmarshalJSON(data []byte) (err error) {
+	var elems []Float
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]Float, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = unmarshalArrayJSON(data, alloc)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// IntArray is generic PostgreSQL array type structure of Int.
+// Elements are kept in row-major order together with the length of each dimension.
+type IntArray struct {
+	ValidFlag
+	elems []Int
+	dims  []int
+}
+
+// MarshalIntArray return generic.IntArray converting of request data
+func MarshalIntArray(x interface{}) (IntArray, error) {
+	v := IntArray{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustIntArray return generic.IntArray converting of request data
+func MustIntArray(x interface{}) IntArray {
+	v, err := MarshalIntArray(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a PostgreSQL array literal.
+func (v IntArray) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a PostgreSQL array literal as string or []byte, or a slice of values convertible to Int.
+func (v *IntArray) Scan(x interface{}) (err error) {
+	var elems []Int
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]Int, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = scanArray(x, alloc, scanArrayElement)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// Weak returns the elements, but if IntArray.ValidFlag is false, returns nil.
+func (v IntArray) Weak() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Set sets a specified value.
+func (v *IntArray) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Elements returns the elements in row-major order
+func (v IntArray) Elements() []Int {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Dims returns the length of each dimension
+func (v IntArray) Dims() []int {
+	if !v.Valid() {
+		return nil
+	}
+	return append([]int(nil), v.dims...)
+}
+
+// Len returns the number of elements
+func (v IntArray) Len() int {
+	if !v.Valid() {
+		return 0
+	}
+	return len(v.elems)
+}
+
+// String implements the Stringer interface.
+// It returns the PostgreSQL array literal, or an empty string if IntArray.ValidFlag is false.
+func (v IntArray) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return formatArrayLiteral(v.dims, len(v.elems), func(i int) (string, bool) {
+		if !v.elems[i].Valid() {
+			return "", false
+		}
+		return v.elems[i].String(), true
+	})
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v IntArray) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return marshalArrayJSON(v.dims, len(v.elems), func(i int) ([]byte, error) {
+		return v.elems[i].MarshalJSON()
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *IntArray) UnmarshalJSON(data []byte) (err error) {
+	var elems []Int
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]Int, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = unmarshalArrayJSON(data, alloc)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// StringArray is generic PostgreSQL array type structure of String.
+// Elements are kept in row-major order together with the length of each dimension.
+type StringArray struct {
+	ValidFlag
+	elems []String
+	dims  []int
+}
+
+// MarshalStringArray return generic.StringArray converting of request data
+func MarshalStringArray(x interface{}) (StringArray, error) {
+	v := StringArray{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustStringArray return generic.StringArray converting of request data
+func MustStringArray(x interface{}) StringArray {
+	v, err := MarshalStringArray(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a PostgreSQL array literal.
+func (v StringArray) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a PostgreSQL array literal as string or []byte, or a slice of values convertible to String.
+func (v *StringArray) Scan(x interface{}) (err error) {
+	var elems []String
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]String, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = scanArray(x, alloc, scanArrayElement)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// Weak returns the elements, but if StringArray.ValidFlag is false, returns nil.
+func (v StringArray) Weak() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Set sets a specified value.
+func (v *StringArray) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Elements returns the elements in row-major order
+func (v StringArray) Elements() []String {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Dims returns the length of each dimension
+func (v StringArray) Dims() []int {
+	if !v.Valid() {
+		return nil
+	}
+	return append([]int(nil), v.dims...)
+}
+
+// Len returns the number of elements
+func (v StringArray) Len() int {
+	if !v.Valid() {
+		return 0
+	}
+	return len(v.elems)
+}
+
+// String implements the Stringer interface.
+// It returns the PostgreSQL array literal, or an empty string if StringArray.ValidFlag is false.
+func (v StringArray) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return formatArrayLiteral(v.dims, len(v.elems), func(i int) (string, bool) {
+		if !v.elems[i].Valid() {
+			return "", false
+		}
+		return v.elems[i].String(), true
+	})
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v StringArray) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return marshalArrayJSON(v.dims, len(v.elems), func(i int) ([]byte, error) {
+		return v.elems[i].MarshalJSON()
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *StringArray) UnmarshalJSON(data []byte) (err error) {
+	var elems []String
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]String, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = unmarshalArrayJSON(data, alloc)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// TimeArray is generic PostgreSQL array type structure of Time.
+// Elements are kept in row-major order together with the length of each dimension.
+type TimeArray struct {
+	ValidFlag
+	elems []Time
+	dims  []int
+}
+
+// MarshalTimeArray return generic.TimeArray converting of request data
+func MarshalTimeArray(x interface{}) (TimeArray, error) {
+	v := TimeArray{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustTimeArray return generic.TimeArray converting of request data
+func MustTimeArray(x interface{}) TimeArray {
+	v, err := MarshalTimeArray(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a PostgreSQL array literal.
+func (v TimeArray) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a PostgreSQL array literal as string or []byte, or a slice of values convertible to Time.
+func (v *TimeArray) Scan(x interface{}) (err error) {
+	var elems []Time
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]Time, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = scanArray(x, alloc, parseTimeLiteral)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// Weak returns the elements, but if TimeArray.ValidFlag is false, returns nil.
+func (v TimeArray) Weak() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Set sets a specified value.
+func (v *TimeArray) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Elements returns the elements in row-major order
+func (v TimeArray) Elements() []Time {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Dims returns the length of each dimension
+func (v TimeArray) Dims() []int {
+	if !v.Valid() {
+		return nil
+	}
+	return append([]int(nil), v.dims...)
+}
+
+// Len returns the number of elements
+func (v TimeArray) Len() int {
+	if !v.Valid() {
+		return 0
+	}
+	return len(v.elems)
+}
+
+// String implements the Stringer interface.
+// It returns the PostgreSQL array literal, or an empty string if TimeArray.ValidFlag is false.
+func (v TimeArray) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return formatArrayLiteral(v.dims, len(v.elems), func(i int) (string, bool) {
+		if !v.elems[i].Valid() {
+			return "", false
+		}
+		return v.elems[i].Time().Format(time.RFC3339Nano), true
+	})
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v TimeArray) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return marshalArrayJSON(v.dims, len(v.elems), func(i int) ([]byte, error) {
+		return v.elems[i].MarshalJSON()
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *TimeArray) UnmarshalJSON(data []byte) (err error) {
+	var elems []Time
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]Time, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = unmarshalArrayJSON(data, alloc)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// UintArray is generic PostgreSQL array type structure of Uint.
+// Elements are kept in row-major order together with the length of each dimension.
+type UintArray struct {
+	ValidFlag
+	elems []Uint
+	dims  []int
+}
+
+// MarshalUintArray return generic.UintArray converting of request data
+func MarshalUintArray(x interface{}) (UintArray, error) {
+	v := UintArray{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustUintArray return generic.UintArray converting of request data
+func MustUintArray(x interface{}) UintArray {
+	v, err := MarshalUintArray(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a PostgreSQL array literal.
+func (v UintArray) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a PostgreSQL array literal as string or []byte, or a slice of values convertible to Uint.
+func (v *UintArray) Scan(x interface{}) (err error) {
+	var elems []Uint
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]Uint, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = scanArray(x, alloc, scanArrayElement)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// Weak returns the elements, but if UintArray.ValidFlag is false, returns nil.
+func (v UintArray) Weak() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Set sets a specified value.
+func (v *UintArray) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Elements returns the elements in row-major order
+func (v UintArray) Elements() []Uint {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Dims returns the length of each dimension
+func (v UintArray) Dims() []int {
+	if !v.Valid() {
+		return nil
+	}
+	return append([]int(nil), v.dims...)
+}
+
+// Len returns the number of elements
+func (v UintArray) Len() int {
+	if !v.Valid() {
+		return 0
+	}
+	return len(v.elems)
+}
+
+// String implements the Stringer interface.
+// It returns the PostgreSQL array literal, or an empty string if UintArray.ValidFlag is false.
+func (v UintArray) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return formatArrayLiteral(v.dims, len(v.elems), func(i int) (string, bool) {
+		if !v.elems[i].Valid() {
+			return "", false
+		}
+		return v.elems[i].String(), true
+	})
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v UintArray) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return marshalArrayJSON(v.dims, len(v.elems), func(i int) ([]byte, error) {
+		return v.elems[i].MarshalJSON()
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *UintArray) UnmarshalJSON(data []byte) (err error) {
+	var elems []Uint
+	alloc := func(n int) func(int) arrayElem {
+		elems = make([]Uint, n)
+		return func(i int) arrayElem {
+			return &elems[i]
+		}
+	}
+	v.dims, v.ValidFlag, err = unmarshalArrayJSON(data, alloc)
+	if err != nil {
+		v.elems, v.dims, v.ValidFlag = nil, nil, false
+		return err
+	}
+	v.elems = elems
+	return
+}
+
+// scanArrayElement sets the text of an array element.
+func scanArrayElement(e arrayElem, s string) error {
+	return e.Scan(s)
+}
+
+// parseTimeLiteral sets the text of a timestamp or timestamptz array element.
+// The text is parsed with DefaultTimeParseOptions, so layouts added by RegisterTimeLayout apply.
+func parseTimeLiteral(e arrayElem, s string) error {
+	t, err := parseTimeString(s, DefaultTimeParseOptions)
+	if err != nil {
+		return err
+	}
+	return e.Scan(t)
+}
+
+// floatLiteral formats f as PostgreSQL does, spelling infinities as Infinity.
+func floatLiteral(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
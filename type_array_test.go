@@ -0,0 +1,146 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestIntArrayScan(t *testing.T) {
+	v, err := MarshalIntArray([]byte("{{1,2},{NULL,4}}"))
+	if err != nil {
+		t.Fatalf("MarshalIntArray() error = %v", err)
+	}
+	want := []Int{MustInt(1), MustInt(2), {}, MustInt(4)}
+	got := v.Elements()
+	if len(got) != len(want) {
+		t.Fatalf("IntArray.Elements() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("IntArray.Elements()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if d := v.Dims(); len(d) != 2 || d[0] != 2 || d[1] != 2 {
+		t.Errorf("IntArray.Dims() = %v, want [2 2]", d)
+	}
+	dv, _ := v.Value()
+	if dv != "{{1,2},{NULL,4}}" {
+		t.Errorf("IntArray.Value() = %v", dv)
+	}
+	b, _ := json.Marshal(v)
+	if string(b) != "[[1,2],[null,4]]" {
+		t.Errorf("json.Marshal(IntArray) = %s", b)
+	}
+}
+
+func TestIntArrayScanSlice(t *testing.T) {
+	v := MustIntArray([]interface{}{1, nil, "3"})
+	if s := v.String(); s != "{1,NULL,3}" {
+		t.Errorf("IntArray.String() = %s", s)
+	}
+	if err := v.Scan("{a}"); err == nil {
+		t.Error("IntArray.Scan() expected error")
+	}
+	if v.Valid() {
+		t.Error("IntArray.Valid() = true after error")
+	}
+	if err := v.Scan(nil); err != nil || v.Valid() {
+		t.Errorf("IntArray.Scan(nil) = %v, valid %v", err, v.Valid())
+	}
+	if err := v.Scan(1); err == nil {
+		t.Error("IntArray.Scan(1) expected error")
+	}
+}
+
+func TestIntArrayEmpty(t *testing.T) {
+	v := MustIntArray("{}")
+	if !v.Valid() || v.Len() != 0 {
+		t.Errorf("IntArray = %v, want valid and empty", v)
+	}
+	b, _ := json.Marshal(v)
+	if string(b) != "[]" {
+		t.Errorf("json.Marshal(IntArray) = %s", b)
+	}
+	b, _ = json.Marshal(IntArray{})
+	if string(b) != "null" {
+		t.Errorf("json.Marshal(IntArray) = %s", b)
+	}
+}
+
+func TestIntArrayUnmarshalJSON(t *testing.T) {
+	var v IntArray
+	if err := json.Unmarshal([]byte(`[1, null, "3"]`), &v); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if s := v.String(); s != "{1,NULL,3}" {
+		t.Errorf("IntArray.String() = %s", s)
+	}
+	if err := json.Unmarshal([]byte(`[[1,2],[3,4]]`), &v); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if s := v.String(); s != "{{1,2},{3,4}}" {
+		t.Errorf("IntArray.String() = %s", s)
+	}
+	if err := json.Unmarshal([]byte(`[[1,2],[3]]`), &v); err == nil {
+		t.Error("json.Unmarshal() expected error for ragged array")
+	}
+	if err := json.Unmarshal([]byte(`null`), &v); err != nil || v.Valid() {
+		t.Errorf("json.Unmarshal(null) = %v, valid %v", err, v.Valid())
+	}
+}
+
+func TestStringArray(t *testing.T) {
+	v := MustStringArray(`{"a b",NULL,"NULL","x\"y"}`)
+	dv, _ := v.Value()
+	if dv != `{"a b",NULL,"NULL","x\"y"}` {
+		t.Errorf("StringArray.Value() = %v", dv)
+	}
+	b, _ := json.Marshal(MustStringArray(`{"a b",NULL,"NULL"}`))
+	if string(b) != `["a b",null,"NULL"]` {
+		t.Errorf("json.Marshal(StringArray) = %s", b)
+	}
+}
+
+func TestBoolArray(t *testing.T) {
+	v := MustBoolArray("{t,f,NULL}")
+	if s := v.String(); s != "{t,f,NULL}" {
+		t.Errorf("BoolArray.String() = %s", s)
+	}
+}
+
+func TestFloatArray(t *testing.T) {
+	v := MustFloatArray("{1.5,Infinity,-Infinity}")
+	if s := v.String(); s != "{1.5,Infinity,-Infinity}" {
+		t.Errorf("FloatArray.String() = %s", s)
+	}
+}
+
+func TestTimeArray(t *testing.T) {
+	v, err := MarshalTimeArray(`{"2020-07-24 20:00:00+09","2020-07-24 11:00:00.5+00",NULL}`)
+	if err != nil {
+		t.Fatalf("MarshalTimeArray() error = %v", err)
+	}
+	e := v.Elements()
+	want := time.Date(2020, 7, 24, 11, 0, 0, 0, time.UTC)
+	if !e[0].Time().Equal(want) || !e[1].Time().Equal(want.Add(500*time.Millisecond)) || e[2].Valid() {
+		t.Errorf("TimeArray.Elements() = %v", e)
+	}
+	v = MustTimeArray([]time.Time{want})
+	if s := v.String(); s != "{2020-07-24T11:00:00Z}" {
+		t.Errorf("TimeArray.String() = %s", s)
+	}
+	var u TimeArray
+	if err := json.Unmarshal([]byte(`["2020-07-24T11:00:00Z",null]`), &u); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if u.Len() != 2 || u.Elements()[1].Valid() {
+		t.Errorf("TimeArray = %v", u.Elements())
+	}
+}
+
+func TestUintArray(t *testing.T) {
+	if _, err := MarshalUintArray("{1,-1}"); err == nil {
+		t.Error("MarshalUintArray() expected error")
+	}
+}
@@ -1,6 +1,7 @@
 package generic
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 )
@@ -94,3 +95,109 @@ func (v *Bool) UnmarshalJSON(data []byte) error {
 	}
 	return v.Scan(in)
 }
+
+// And returns the logical conjunction of v and x using three-valued logic.
+// An invalid operand is treated as UNKNOWN, so false AND UNKNOWN is false and true AND UNKNOWN is UNKNOWN.
+func (v Bool) And(x Bool) Bool {
+	if (v.Valid() && !v.bool) || (x.Valid() && !x.bool) {
+		return Bool{ValidFlag: true, bool: false}
+	}
+	if v.Valid() && x.Valid() {
+		return Bool{ValidFlag: true, bool: true}
+	}
+	return Bool{}
+}
+
+// LogicalOr returns the logical disjunction of v and x using three-valued logic.
+// It is not named Or, which returns the bool value or a default like the other types.
+// An invalid operand is treated as UNKNOWN, so true OR UNKNOWN is true and false OR UNKNOWN is UNKNOWN.
+func (v Bool) LogicalOr(x Bool) Bool {
+	if (v.Valid() && v.bool) || (x.Valid() && x.bool) {
+		return Bool{ValidFlag: true, bool: true}
+	}
+	if v.Valid() && x.Valid() {
+		return Bool{ValidFlag: true, bool: false}
+	}
+	return Bool{}
+}
+
+// Not returns the logical negation of v. NOT UNKNOWN is UNKNOWN.
+func (v Bool) Not() Bool {
+	if !v.Valid() {
+		return Bool{}
+	}
+	return Bool{ValidFlag: true, bool: !v.bool}
+}
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Bool) Equal(x Bool) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.bool == x.bool
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Bool) Compare(x Bool, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.bool == x.bool:
+		return 0
+	case !v.bool:
+		return -1
+	}
+	return 1
+}
+
+// Or returns the bool value, but if Bool.ValidFlag is false, returns d.
+func (v Bool) Or(d bool) bool {
+	if !v.Valid() {
+		return d
+	}
+	return v.bool
+}
+
+// OrElse returns the bool value, but if Bool.ValidFlag is false, returns the result of f.
+func (v Bool) OrElse(f func() bool) bool {
+	if !v.Valid() {
+		return f()
+	}
+	return v.bool
+}
+
+// Ptr returns a pointer to a copy of the bool value, but if Bool.ValidFlag is false, returns nil.
+func (v Bool) Ptr() *bool {
+	if !v.Valid() {
+		return nil
+	}
+	x := v.bool
+	return &x
+}
+
+// BoolFromPtr returns generic.Bool holding *p, or an invalid Bool if p is nil.
+func BoolFromPtr(p *bool) Bool {
+	if p == nil {
+		return Bool{}
+	}
+	return Bool{ValidFlag: true, bool: *p}
+}
+
+// NullBool converts v to sql.NullBool.
+func (v Bool) NullBool() sql.NullBool {
+	if !v.Valid() {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: v.bool, Valid: true}
+}
+
+// FromNullBool returns generic.Bool converting of sql.NullBool
+func FromNullBool(x sql.NullBool) Bool {
+	if !x.Valid {
+		return Bool{}
+	}
+	return Bool{ValidFlag: true, bool: x.Bool}
+}
@@ -226,3 +226,52 @@ func TestBoolStringInvalid(t *testing.T) {
 		t.Errorf("actual:%s, expected:false", ts.String())
 	}
 }
+
+func TestBoolThreeValuedLogic(t *testing.T) {
+	tr := Bool{ValidFlag: true, bool: true}
+	fa := Bool{ValidFlag: true, bool: false}
+	un := Bool{}
+	tests := []struct {
+		name    string
+		v       Bool
+		x       Bool
+		wantAnd Bool
+		wantOr  Bool
+	}{
+		{name: "true,true", v: tr, x: tr, wantAnd: tr, wantOr: tr},
+		{name: "true,false", v: tr, x: fa, wantAnd: fa, wantOr: tr},
+		{name: "true,unknown", v: tr, x: un, wantAnd: un, wantOr: tr},
+		{name: "false,false", v: fa, x: fa, wantAnd: fa, wantOr: fa},
+		{name: "false,unknown", v: fa, x: un, wantAnd: fa, wantOr: un},
+		{name: "unknown,false", v: un, x: fa, wantAnd: fa, wantOr: un},
+		{name: "unknown,unknown", v: un, x: un, wantAnd: un, wantOr: un},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.And(tt.x); got != tt.wantAnd {
+				t.Errorf("Bool.And() = %v, want %v", got, tt.wantAnd)
+			}
+			if got := tt.v.LogicalOr(tt.x); got != tt.wantOr {
+				t.Errorf("Bool.LogicalOr() = %v, want %v", got, tt.wantOr)
+			}
+		})
+	}
+}
+
+func TestBoolNot(t *testing.T) {
+	if got := (Bool{ValidFlag: true, bool: true}).Not(); got != (Bool{ValidFlag: true, bool: false}) {
+		t.Errorf("Bool.Not() = %v, want false", got)
+	}
+	if got := (Bool{}).Not(); got.Valid() {
+		t.Errorf("Bool.Not() = %v, want invalid", got)
+	}
+}
+
+func TestBoolOr(t *testing.T) {
+	if got := (Bool{}).Or(true); !got {
+		t.Errorf("Bool.Or() = %v, want true", got)
+	}
+	if got := (Bool{ValidFlag: true, bool: false}).Or(true); got {
+		t.Errorf("Bool.Or() = %v, want false", got)
+	}
+}
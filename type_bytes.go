@@ -0,0 +1,175 @@
+package generic
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// BytesEncoding is the text encoding of Bytes in JSON
+type BytesEncoding int
+
+const (
+	// BytesBase64 is the standard base64 encoding defined in RFC 4648
+	BytesBase64 BytesEncoding = iota
+	// BytesBase64URL is the URL-safe base64 encoding defined in RFC 4648
+	BytesBase64URL
+	// BytesHex is the hexadecimal encoding
+	BytesHex
+)
+
+// Bytes is generic []byte type structure
+type Bytes struct {
+	ValidFlag
+	bytes    []byte
+	encoding BytesEncoding
+}
+
+// MarshalBytes return generic.Bytes converting of request data
+func MarshalBytes(x interface{}) (Bytes, error) {
+	v := Bytes{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustBytes return generic.Bytes converting of request data
+func MustBytes(x interface{}) Bytes {
+	v, err := MarshalBytes(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+func (v Bytes) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.bytes, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// The scanned bytes are copied, so x may be reused by the caller.
+func (v *Bytes) Scan(x interface{}) (err error) {
+	v.bytes, v.ValidFlag, err = asBytes(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns []byte, but if Bytes.ValidFlag is false, returns nil.
+func (v Bytes) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *Bytes) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Bytes returns []byte value. The returned slice must not be modified.
+func (v Bytes) Bytes() []byte {
+	if !v.Valid() {
+		return nil
+	}
+	return v.bytes
+}
+
+// Len returns the number of bytes
+func (v Bytes) Len() int {
+	if !v.Valid() {
+		return 0
+	}
+	return len(v.bytes)
+}
+
+// Encoding returns the encoding used in JSON
+func (v Bytes) Encoding() BytesEncoding {
+	return v.encoding
+}
+
+// WithEncoding returns a copy of v which uses e in JSON.
+// The encoding is kept by Scan, Set and UnmarshalJSON.
+func (v Bytes) WithEncoding(e BytesEncoding) Bytes {
+	v.encoding = e
+	return v
+}
+
+// String implements the Stringer interface.
+// It returns the bytes in the encoding of v.
+func (v Bytes) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	switch v.encoding {
+	case BytesBase64URL:
+		return base64.URLEncoding.EncodeToString(v.bytes)
+	case BytesHex:
+		return hex.EncodeToString(v.bytes)
+	}
+	return base64.StdEncoding.EncodeToString(v.bytes)
+}
+
+// Equal reports whether v and x hold the same bytes, regardless of their encodings.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Bytes) Equal(x Bytes) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return bytes.Equal(v.bytes, x.bytes)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Bytes) Compare(x Bytes, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	return bytes.Compare(v.bytes, x.bytes)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Bytes) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return []byte(`"` + v.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The JSON string is decoded with the encoding of v.
+func (v *Bytes) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var in interface{}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	s, ok := in.(string)
+	if !ok {
+		return v.Scan(in)
+	}
+	var b []byte
+	var err error
+	switch v.encoding {
+	case BytesBase64URL:
+		b, err = base64.URLEncoding.DecodeString(s)
+	case BytesHex:
+		b, err = hex.DecodeString(s)
+	default:
+		b, err = base64.StdEncoding.DecodeString(s)
+	}
+	if err != nil {
+		v.bytes, v.ValidFlag = nil, false
+		return ErrInvalidGenericValue{Value: s}
+	}
+	v.bytes, v.ValidFlag = b, true
+	return nil
+}
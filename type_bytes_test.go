@@ -0,0 +1,104 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBytesScanCopies(t *testing.T) {
+	buf := []byte{1, 2, 3}
+	var v Bytes
+	if err := v.Scan(buf); err != nil {
+		t.Fatalf("Bytes.Scan() error = %v", err)
+	}
+	buf[0] = 9
+	if v.Bytes()[0] != 1 {
+		t.Errorf("Bytes.Scan() shares memory with the source")
+	}
+	if v.Len() != 3 {
+		t.Errorf("Bytes.Len() = %d, want 3", v.Len())
+	}
+}
+
+func TestMarshalBytes(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      interface{}
+		wantValid bool
+		wantErr   bool
+	}{
+		{name: "bytes", args: []byte("abc"), wantValid: true},
+		{name: "empty bytes", args: []byte{}, wantValid: true},
+		{name: "string", args: "abc", wantValid: true},
+		{name: "nil", args: nil},
+		{name: "nil bytes", args: []byte(nil)},
+		{name: "int", args: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalBytes(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalBytes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Valid() != tt.wantValid {
+				t.Errorf("MarshalBytes() valid = %v, want %v", got.Valid(), tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestBytesJSONEncodings(t *testing.T) {
+	data := []byte{0xfb, 0xff, 0x01}
+	tests := []struct {
+		name     string
+		encoding BytesEncoding
+		want     string
+	}{
+		{name: "base64", encoding: BytesBase64, want: `"+/8B"`},
+		{name: "base64url", encoding: BytesBase64URL, want: `"-_8B"`},
+		{name: "hex", encoding: BytesHex, want: `"fbff01"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := MustBytes(data).WithEncoding(tt.encoding)
+			b, err := json.Marshal(v)
+			if err != nil || string(b) != tt.want {
+				t.Fatalf("json.Marshal() = %s, %v, want %s", b, err, tt.want)
+			}
+			u := Bytes{}.WithEncoding(tt.encoding)
+			if err := json.Unmarshal(b, &u); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if !u.Equal(v) || u.Encoding() != tt.encoding {
+				t.Errorf("json.Unmarshal() = %v, want %v", u.Bytes(), data)
+			}
+		})
+	}
+}
+
+func TestBytesUnmarshalJSONInvalid(t *testing.T) {
+	v := MustBytes("abc")
+	if err := json.Unmarshal([]byte(`"not base64!"`), &v); err == nil || v.Valid() {
+		t.Errorf("json.Unmarshal() = %v, valid %v", err, v.Valid())
+	}
+	v = MustBytes("abc")
+	if err := json.Unmarshal([]byte(`null`), &v); err != nil || v.Valid() {
+		t.Errorf("json.Unmarshal(null) = %v, valid %v", err, v.Valid())
+	}
+	b, _ := json.Marshal(Bytes{})
+	if string(b) != "null" {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+}
+
+func TestBytesEqual(t *testing.T) {
+	if !MustBytes("a").Equal(MustBytes([]byte("a")).WithEncoding(BytesHex)) {
+		t.Error("Bytes.Equal() = false, want true")
+	}
+	if MustBytes("a").Equal(Bytes{}) || !(Bytes{}).Equal(Bytes{}) {
+		t.Error("Bytes.Equal() mismatch for invalid values")
+	}
+	if MustBytes("").Equal(Bytes{}) {
+		t.Error("Bytes.Equal() = true for empty and invalid")
+	}
+}
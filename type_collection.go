@@ -0,0 +1,415 @@
+package generic
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// CollectionElem is the constraint of the element pointer type of Slice and Map.
+// It is satisfied by the pointers to generic types such as *Int and *String.
+type CollectionElem[T any] interface {
+	*T
+	arrayElem
+}
+
+// Slice is generic slice type structure of generic types such as Slice[Int, *Int].
+// Each element keeps its own validity, so null elements survive a round trip.
+//
+// Slice is stored in database columns as a JSON array.
+type Slice[T any, PT CollectionElem[T]] struct {
+	ValidFlag
+	elems []T
+}
+
+// MarshalSlice return generic.Slice converting of request data
+func MarshalSlice[T any, PT CollectionElem[T]](x interface{}) (Slice[T, PT], error) {
+	v := Slice[T, PT]{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustSlice return generic.Slice converting of request data
+func MustSlice[T any, PT CollectionElem[T]](x interface{}) Slice[T, PT] {
+	v, err := MarshalSlice[T, PT](x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a JSON array.
+func (v Slice[T, PT]) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	b, err := v.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a JSON array as string or []byte, or a slice whose elements are converted one by one.
+func (v *Slice[T, PT]) Scan(x interface{}) (err error) {
+	switch t := x.(type) {
+	case nil:
+		v.elems, v.ValidFlag = nil, false
+		return nil
+	case string:
+		return v.UnmarshalJSON([]byte(t))
+	case []byte:
+		return v.UnmarshalJSON(t)
+	case json.RawMessage:
+		return v.UnmarshalJSON(t)
+	}
+	rv := reflect.ValueOf(x)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		v.elems, v.ValidFlag = nil, false
+		return ErrInvalidGenericValue{Value: x}
+	}
+	elems := make([]T, rv.Len())
+	for i := range elems {
+		if err = PT(&elems[i]).Scan(rv.Index(i).Interface()); err != nil {
+			v.elems, v.ValidFlag = nil, false
+			return err
+		}
+	}
+	v.elems, v.ValidFlag = elems, true
+	return nil
+}
+
+// Weak returns the elements, but if Slice.ValidFlag is false, returns nil.
+func (v Slice[T, PT]) Weak() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Set sets a specified value.
+func (v *Slice[T, PT]) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Elements returns all elements including invalid ones
+func (v Slice[T, PT]) Elements() []T {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Len returns the number of elements including invalid ones
+func (v Slice[T, PT]) Len() int {
+	if !v.Valid() {
+		return 0
+	}
+	return len(v.elems)
+}
+
+// EachValid calls f for each valid element in order until f returns false.
+func (v Slice[T, PT]) EachValid(f func(i int, e T) bool) {
+	if !v.Valid() {
+		return
+	}
+	for i := range v.elems {
+		if !PT(&v.elems[i]).Valid() {
+			continue
+		}
+		if !f(i, v.elems[i]) {
+			return
+		}
+	}
+}
+
+// Compact returns a Slice without invalid elements.
+// If Slice.ValidFlag is false, Compact returns an invalid Slice.
+func (v Slice[T, PT]) Compact() Slice[T, PT] {
+	if !v.Valid() {
+		return Slice[T, PT]{}
+	}
+	elems := make([]T, 0, len(v.elems))
+	v.EachValid(func(_ int, e T) bool {
+		elems = append(elems, e)
+		return true
+	})
+	return Slice[T, PT]{ValidFlag: true, elems: elems}
+}
+
+// String implements the Stringer interface.
+// It returns the JSON array, or an empty string if Slice.ValidFlag is false.
+func (v Slice[T, PT]) String() string {
+	s, _ := v.Value()
+	if s == nil {
+		return ""
+	}
+	return s.(string)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Slice[T, PT]) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	buf := bytes.Buffer{}
+	buf.WriteByte('[')
+	for i := range v.elems {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		b, err := PT(&v.elems[i]).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Each element is decoded by the element type, so its own coercion applies.
+func (v *Slice[T, PT]) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || bytes.Equal(bytes.TrimSpace(data), nullBytes) {
+		v.elems, v.ValidFlag = nil, false
+		return nil
+	}
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		v.elems, v.ValidFlag = nil, false
+		return err
+	}
+	elems := make([]T, len(raws))
+	for i, raw := range raws {
+		if err := unmarshalCollectionElem(PT(&elems[i]), raw); err != nil {
+			v.elems, v.ValidFlag = nil, false
+			return err
+		}
+	}
+	v.elems, v.ValidFlag = elems, true
+	return nil
+}
+
+// Map is generic string-keyed map type structure of generic types such as Map[Int, *Int].
+// Each element keeps its own validity, so null elements survive a round trip.
+//
+// Map is stored in database columns as a JSON object.
+type Map[T any, PT CollectionElem[T]] struct {
+	ValidFlag
+	elems map[string]T
+}
+
+// MarshalMap return generic.Map converting of request data
+func MarshalMap[T any, PT CollectionElem[T]](x interface{}) (Map[T, PT], error) {
+	v := Map[T, PT]{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustMap return generic.Map converting of request data
+func MustMap[T any, PT CollectionElem[T]](x interface{}) Map[T, PT] {
+	v, err := MarshalMap[T, PT](x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a JSON object.
+func (v Map[T, PT]) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	b, err := v.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a JSON object as string or []byte, or a string-keyed map whose elements are converted one by one.
+func (v *Map[T, PT]) Scan(x interface{}) (err error) {
+	switch t := x.(type) {
+	case nil:
+		v.elems, v.ValidFlag = nil, false
+		return nil
+	case string:
+		return v.UnmarshalJSON([]byte(t))
+	case []byte:
+		return v.UnmarshalJSON(t)
+	case json.RawMessage:
+		return v.UnmarshalJSON(t)
+	}
+	rv := reflect.ValueOf(x)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		v.elems, v.ValidFlag = nil, false
+		return ErrInvalidGenericValue{Value: x}
+	}
+	elems := make(map[string]T, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		var e T
+		if err = PT(&e).Scan(iter.Value().Interface()); err != nil {
+			v.elems, v.ValidFlag = nil, false
+			return err
+		}
+		elems[iter.Key().String()] = e
+	}
+	v.elems, v.ValidFlag = elems, true
+	return nil
+}
+
+// Weak returns the elements, but if Map.ValidFlag is false, returns nil.
+func (v Map[T, PT]) Weak() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Set sets a specified value.
+func (v *Map[T, PT]) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Elements returns all elements including invalid ones
+func (v Map[T, PT]) Elements() map[string]T {
+	if !v.Valid() {
+		return nil
+	}
+	return v.elems
+}
+
+// Get returns the element for key and whether key is present.
+func (v Map[T, PT]) Get(key string) (T, bool) {
+	e, ok := v.elems[key]
+	return e, ok && v.Valid()
+}
+
+// Len returns the number of elements including invalid ones
+func (v Map[T, PT]) Len() int {
+	if !v.Valid() {
+		return 0
+	}
+	return len(v.elems)
+}
+
+// Keys returns the keys in sorted order
+func (v Map[T, PT]) Keys() []string {
+	if !v.Valid() {
+		return nil
+	}
+	keys := make([]string, 0, len(v.elems))
+	for k := range v.elems {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// EachValid calls f for each valid element in key order until f returns false.
+func (v Map[T, PT]) EachValid(f func(key string, e T) bool) {
+	for _, k := range v.Keys() {
+		e := v.elems[k]
+		if !PT(&e).Valid() {
+			continue
+		}
+		if !f(k, e) {
+			return
+		}
+	}
+}
+
+// Compact returns a Map without invalid elements.
+// If Map.ValidFlag is false, Compact returns an invalid Map.
+func (v Map[T, PT]) Compact() Map[T, PT] {
+	if !v.Valid() {
+		return Map[T, PT]{}
+	}
+	elems := make(map[string]T, len(v.elems))
+	v.EachValid(func(k string, e T) bool {
+		elems[k] = e
+		return true
+	})
+	return Map[T, PT]{ValidFlag: true, elems: elems}
+}
+
+// String implements the Stringer interface.
+// It returns the JSON object, or an empty string if Map.ValidFlag is false.
+func (v Map[T, PT]) String() string {
+	s, _ := v.Value()
+	if s == nil {
+		return ""
+	}
+	return s.(string)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Keys are written in sorted order.
+func (v Map[T, PT]) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	buf := bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, k := range v.Keys() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		kb, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(kb)
+		buf.WriteByte(':')
+		e := v.elems[k]
+		b, err := PT(&e).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Each element is decoded by the element type, so its own coercion applies.
+func (v *Map[T, PT]) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || bytes.Equal(bytes.TrimSpace(data), nullBytes) {
+		v.elems, v.ValidFlag = nil, false
+		return nil
+	}
+	var raws map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		v.elems, v.ValidFlag = nil, false
+		return err
+	}
+	elems := make(map[string]T, len(raws))
+	for k, raw := range raws {
+		var e T
+		if err := unmarshalCollectionElem(PT(&e), raw); err != nil {
+			v.elems, v.ValidFlag = nil, false
+			return err
+		}
+		elems[k] = e
+	}
+	v.elems, v.ValidFlag = elems, true
+	return nil
+}
+
+// unmarshalCollectionElem decodes a JSON element, leaving e invalid for null.
+func unmarshalCollectionElem(e arrayElem, raw json.RawMessage) error {
+	if bytes.Equal(bytes.TrimSpace(raw), nullBytes) {
+		e.Reset()
+		return nil
+	}
+	return e.UnmarshalJSON(raw)
+}
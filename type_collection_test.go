@@ -0,0 +1,133 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSliceUnmarshalJSON(t *testing.T) {
+	var v Slice[Int, *Int]
+	if err := json.Unmarshal([]byte(`[1, null, "3"]`), &v); err != nil {
+		t.Fatalf("Slice.UnmarshalJSON() error = %v", err)
+	}
+	if v.Len() != 3 {
+		t.Fatalf("Slice.Len() = %d, want 3", v.Len())
+	}
+	e := v.Elements()
+	if e[0] != MustInt(1) || e[1].Valid() || e[2] != MustInt(3) {
+		t.Errorf("Slice.Elements() = %v", e)
+	}
+	b, err := json.Marshal(v)
+	if err != nil || string(b) != `[1,null,3]` {
+		t.Errorf("Slice.MarshalJSON() = %s, %v", b, err)
+	}
+	if err := json.Unmarshal([]byte(`[1, "x"]`), &v); err == nil {
+		t.Error("Slice.UnmarshalJSON() expected error")
+	}
+	if v.Valid() {
+		t.Error("Slice.UnmarshalJSON() should reset on error")
+	}
+}
+
+func TestSliceNull(t *testing.T) {
+	var v Slice[String, *String]
+	if err := json.Unmarshal([]byte(`null`), &v); err != nil || v.Valid() {
+		t.Errorf("Slice.UnmarshalJSON(null) = %v, %v", v, err)
+	}
+	b, _ := json.Marshal(v)
+	if string(b) != "null" {
+		t.Errorf("Slice.MarshalJSON() = %s, want null", b)
+	}
+	if err := v.Scan(`[]`); err != nil || !v.Valid() || v.Len() != 0 {
+		t.Errorf("Slice.Scan([]) = %v, %v", v, err)
+	}
+}
+
+func TestSliceScanValue(t *testing.T) {
+	v, err := MarshalSlice[Float]([]interface{}{1, nil, "2.5"})
+	if err != nil {
+		t.Fatalf("MarshalSlice() error = %v", err)
+	}
+	got, err := v.Value()
+	if err != nil || got != `[1,null,2.5]` {
+		t.Errorf("Slice.Value() = %v, %v", got, err)
+	}
+	var w Slice[Float, *Float]
+	if err := w.Scan([]byte(got.(string))); err != nil {
+		t.Fatalf("Slice.Scan() error = %v", err)
+	}
+	if w.String() != v.String() {
+		t.Errorf("Slice.Scan() = %v, want %v", w, v)
+	}
+	if err := w.Scan(1); err == nil {
+		t.Error("Slice.Scan() expected error")
+	}
+}
+
+func TestSliceCompact(t *testing.T) {
+	v := MustSlice[Int](`[null, 1, null, 2]`)
+	var idx []int
+	v.EachValid(func(i int, e Int) bool {
+		idx = append(idx, i)
+		return true
+	})
+	if len(idx) != 2 || idx[0] != 1 || idx[1] != 3 {
+		t.Errorf("Slice.EachValid() indexes = %v, want [1 3]", idx)
+	}
+	c := v.Compact()
+	if c.String() != `[1,2]` {
+		t.Errorf("Slice.Compact() = %v, want [1,2]", c)
+	}
+	if (Slice[Int, *Int]{}).Compact().Valid() {
+		t.Error("Slice.Compact() of invalid value should be invalid")
+	}
+}
+
+func TestMapUnmarshalJSON(t *testing.T) {
+	var v Map[Bool, *Bool]
+	if err := json.Unmarshal([]byte(`{"b": "true", "a": null, "c": 0}`), &v); err != nil {
+		t.Fatalf("Map.UnmarshalJSON() error = %v", err)
+	}
+	if v.Len() != 3 {
+		t.Fatalf("Map.Len() = %d, want 3", v.Len())
+	}
+	if a, ok := v.Get("a"); !ok || a.Valid() {
+		t.Errorf("Map.Get(a) = %v, %v", a, ok)
+	}
+	if _, ok := v.Get("z"); ok {
+		t.Error("Map.Get(z) should not be found")
+	}
+	b, err := json.Marshal(v)
+	if err != nil || string(b) != `{"a":null,"b":true,"c":false}` {
+		t.Errorf("Map.MarshalJSON() = %s, %v", b, err)
+	}
+	c := v.Compact()
+	if c.String() != `{"b":true,"c":false}` {
+		t.Errorf("Map.Compact() = %v", c)
+	}
+	var keys []string
+	v.EachValid(func(k string, e Bool) bool {
+		keys = append(keys, k)
+		return false
+	})
+	if len(keys) != 1 || keys[0] != "b" {
+		t.Errorf("Map.EachValid() keys = %v, want [b]", keys)
+	}
+}
+
+func TestMapScan(t *testing.T) {
+	v, err := MarshalMap[String](map[string]interface{}{"a": 1, "b": nil})
+	if err != nil {
+		t.Fatalf("MarshalMap() error = %v", err)
+	}
+	got, err := v.Value()
+	if err != nil || got != `{"a":"1","b":null}` {
+		t.Errorf("Map.Value() = %v, %v", got, err)
+	}
+	if err := v.Scan(map[int]string{}); err == nil {
+		t.Error("Map.Scan() expected error for non-string keys")
+	}
+	if err := v.Scan(nil); err != nil || v.Valid() {
+		t.Errorf("Map.Scan(nil) = %v, %v", v, err)
+	}
+}
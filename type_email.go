@@ -0,0 +1,240 @@
+package generic
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/mail"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// EmailOptions configures how email addresses are parsed and normalized
+type EmailOptions struct {
+	// AllowDisplayName accepts forms such as "Rick Grimes <rick@example.com>".
+	// The display name is kept apart from the address and never marshaled.
+	AllowDisplayName bool
+
+	// LowercaseLocal lowercases the local part.
+	// The domain is always lowercased, because domains are case-insensitive.
+	LowercaseLocal bool
+}
+
+// DefaultEmailOptions is used by Email.Scan, Email.Set and Email.UnmarshalJSON
+var DefaultEmailOptions = EmailOptions{}
+
+// Email is generic email address type structure
+type Email struct {
+	ValidFlag
+	email emailAddress
+}
+
+type emailAddress struct {
+	name   string
+	local  string
+	domain string
+}
+
+// MarshalEmail return generic.Email converting of request data
+func MarshalEmail(x interface{}) (Email, error) {
+	v := Email{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustEmail return generic.Email converting of request data
+func MustEmail(x interface{}) Email {
+	v, err := MarshalEmail(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ParseEmail return generic.Email parsing s with the specified options
+func ParseEmail(s string, o EmailOptions) (Email, error) {
+	e, err := parseEmail(s, o)
+	if err != nil {
+		return Email{}, err
+	}
+	return Email{ValidFlag: true, email: e}, nil
+}
+
+// Value implements the driver Valuer interface.
+func (v Email) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Strings are parsed with DefaultEmailOptions.
+func (v *Email) Scan(x interface{}) (err error) {
+	v.email, v.ValidFlag, err = asEmail(x, DefaultEmailOptions)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns the normalized address, but if Email.ValidFlag is false, returns nil.
+func (v Email) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *Email) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// String implements the Stringer interface.
+// It returns the normalized address without the display name.
+// A local part that is not a dot-atom, such as "john doe", is quoted so that the address can be parsed again.
+func (v Email) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return quoteLocal(v.email.local) + "@" + v.email.domain
+}
+
+// Local returns the local part of the address
+func (v Email) Local() string {
+	if !v.Valid() {
+		return ""
+	}
+	return v.email.local
+}
+
+// Domain returns the domain of the address in its lowercase ASCII form
+func (v Email) Domain() string {
+	if !v.Valid() {
+		return ""
+	}
+	return v.email.domain
+}
+
+// DomainUnicode returns the domain of the address in its Unicode form
+func (v Email) DomainUnicode() string {
+	if !v.Valid() {
+		return ""
+	}
+	d, err := idna.Display.ToUnicode(v.email.domain)
+	if err != nil {
+		return v.email.domain
+	}
+	return d
+}
+
+// Name returns the display name, which is only set when EmailOptions.AllowDisplayName is true.
+func (v Email) Name() string {
+	if !v.Valid() {
+		return ""
+	}
+	return v.email.name
+}
+
+// Equal reports whether v and x are the same address.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Email) Equal(x Email) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.email.local == x.email.local && v.email.domain == x.email.domain
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Email) Compare(x Email, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	return strings.Compare(v.String(), x.String())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Email) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *Email) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var in interface{}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	return v.Scan(in)
+}
+
+// parseEmail parses and normalizes an email address.
+func parseEmail(s string, o EmailOptions) (result emailAddress, err error) {
+	a, err := mail.ParseAddress(s)
+	if err != nil {
+		return result, ErrInvalidGenericValue{Value: s}
+	}
+	if !o.AllowDisplayName && (a.Name != "" || strings.HasSuffix(strings.TrimSpace(s), ">")) {
+		return result, ErrInvalidGenericValue{Value: s}
+	}
+	i := strings.LastIndexByte(a.Address, '@')
+	if i < 0 {
+		return result, ErrInvalidGenericValue{Value: s}
+	}
+	result.name = a.Name
+	result.local = a.Address[:i]
+	result.domain = a.Address[i+1:]
+	if o.LowercaseLocal {
+		result.local = strings.ToLower(result.local)
+	}
+	if strings.HasPrefix(result.domain, "[") {
+		// domain literals such as [192.0.2.1] are not subject to IDNA
+		return result, nil
+	}
+	if result.domain, err = idna.Lookup.ToASCII(result.domain); err != nil {
+		return result, ErrInvalidGenericValue{Value: s}
+	}
+	return result, nil
+}
+
+// quoteLocal returns local as a quoted string if it is not a dot-atom of RFC 5322.
+func quoteLocal(local string) string {
+	if isDotAtom(local) {
+		return local
+	}
+	buf := strings.Builder{}
+	buf.WriteByte('"')
+	for i := 0; i < len(local); i++ {
+		if local[i] == '"' || local[i] == '\\' {
+			buf.WriteByte('\\')
+		}
+		buf.WriteByte(local[i])
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
+
+// isDotAtom reports whether s is atoms joined by single dots.
+// Non-ASCII characters are atext, as RFC 6532 allows.
+func isDotAtom(s string) bool {
+	if s == "" || s[0] == '.' || s[len(s)-1] == '.' || strings.Contains(s, "..") {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 0x80, c == '.',
+			'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			strings.IndexByte("!#$%&'*+-/=?^_`{|}~", c) >= 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
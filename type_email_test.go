@@ -0,0 +1,88 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseEmail(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       string
+		opts       EmailOptions
+		want       string
+		wantLocal  string
+		wantDomain string
+		wantName   string
+		wantErr    bool
+	}{
+		{name: "simple", args: "rick@example.com", want: "rick@example.com", wantLocal: "rick", wantDomain: "example.com"},
+		{name: "domain case", args: "Rick@Example.COM", want: "Rick@example.com", wantLocal: "Rick", wantDomain: "example.com"},
+		{name: "lowercase local", args: "Rick@Example.COM", opts: EmailOptions{LowercaseLocal: true}, want: "rick@example.com", wantLocal: "rick", wantDomain: "example.com"},
+		{name: "idna", args: "user@bücher.example", want: "user@xn--bcher-kva.example", wantLocal: "user", wantDomain: "xn--bcher-kva.example"},
+		{name: "display name rejected", args: "Rick Grimes <rick@example.com>", wantErr: true},
+		{name: "angle brackets rejected", args: "<rick@example.com>", wantErr: true},
+		{name: "display name allowed", args: "Rick Grimes <rick@example.com>", opts: EmailOptions{AllowDisplayName: true}, want: "rick@example.com", wantLocal: "rick", wantDomain: "example.com", wantName: "Rick Grimes"},
+		{name: "quoted local", args: `"john doe"@example.com`, want: `"john doe"@example.com`, wantLocal: "john doe", wantDomain: "example.com"},
+		{name: "quoted local with escapes", args: `"a\"b\\c"@example.com`, want: `"a\"b\\c"@example.com`, wantLocal: `a"b\c`, wantDomain: "example.com"},
+		{name: "needlessly quoted local", args: `"rick"@example.com`, want: "rick@example.com", wantLocal: "rick", wantDomain: "example.com"},
+		{name: "no domain", args: "rick", wantErr: true},
+		{name: "invalid domain", args: "rick@exa_mple.com", wantErr: true},
+		{name: "empty", args: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEmail(tt.args, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseEmail() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.String() != tt.want || got.Local() != tt.wantLocal || got.Domain() != tt.wantDomain || got.Name() != tt.wantName {
+				t.Errorf("ParseEmail() = %q (local %q, domain %q, name %q)", got.String(), got.Local(), got.Domain(), got.Name())
+			}
+		})
+	}
+}
+
+func TestEmailQuotedLocalRoundTrip(t *testing.T) {
+	v := MustEmail(`"john doe"@example.com`)
+	b, err := json.Marshal(v)
+	if err != nil || string(b) != `"\"john doe\"@example.com"` {
+		t.Fatalf("Email.MarshalJSON() = %s, %v", b, err)
+	}
+	var w Email
+	if err = json.Unmarshal(b, &w); err != nil || w.Local() != "john doe" {
+		t.Errorf("Email.UnmarshalJSON() = %v, %v", w, err)
+	}
+	dv, _ := v.Value()
+	if x, err := MarshalEmail(dv); err != nil || x.String() != v.String() {
+		t.Errorf("MarshalEmail(Email.Value()) = %v, %v, want %v", x, err, v)
+	}
+}
+
+func TestEmailDomainUnicode(t *testing.T) {
+	v := MustEmail("user@xn--bcher-kva.example")
+	if got := v.DomainUnicode(); got != "bücher.example" {
+		t.Errorf("Email.DomainUnicode() = %v", got)
+	}
+}
+
+func TestEmailJSONAndValue(t *testing.T) {
+	var s struct {
+		Email Email `json:"email"`
+		Empty Email `json:"empty"`
+	}
+	if err := json.Unmarshal([]byte(`{"email":"user@BÜCHER.example","empty":null}`), &s); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	b, _ := json.Marshal(s)
+	if string(b) != `{"email":"user@xn--bcher-kva.example","empty":null}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+	dv, _ := s.Email.Value()
+	if dv != "user@xn--bcher-kva.example" {
+		t.Errorf("Email.Value() = %v", dv)
+	}
+	if err := s.Email.Scan(1); err == nil || s.Email.Valid() {
+		t.Errorf("Email.Scan(1) = %v, valid %v", err, s.Email.Valid())
+	}
+}
@@ -0,0 +1,266 @@
+package generic
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// EnumMember is a member of an EnumSet
+type EnumMember struct {
+	Name    string
+	Ordinal int64
+}
+
+// EnumSet is a registered set of allowed values for Enum
+type EnumSet struct {
+	members    []EnumMember
+	byName     map[string]int
+	byOrdinal  map[int64]int
+	useOrdinal bool
+}
+
+// NewEnumSet returns an EnumSet of named integer members.
+// Enum values of the set are stored in databases by ordinal.
+func NewEnumSet(members ...EnumMember) (*EnumSet, error) {
+	s := &EnumSet{
+		members:    make([]EnumMember, 0, len(members)),
+		byName:     make(map[string]int, len(members)),
+		byOrdinal:  make(map[int64]int, len(members)),
+		useOrdinal: true,
+	}
+	for _, m := range members {
+		if m.Name == "" {
+			return nil, errors.New("enum member must have a name")
+		}
+		if _, ok := s.byName[m.Name]; ok {
+			return nil, errors.New("duplicate enum member name: " + m.Name)
+		}
+		if _, ok := s.byOrdinal[m.Ordinal]; ok {
+			return nil, errors.New("duplicate enum member ordinal: " + strconv.FormatInt(m.Ordinal, 10))
+		}
+		s.byName[m.Name] = len(s.members)
+		s.byOrdinal[m.Ordinal] = len(s.members)
+		s.members = append(s.members, m)
+	}
+	return s, nil
+}
+
+// NewStringEnumSet returns an EnumSet of string members whose ordinals are their positions.
+// Enum values of the set are stored in databases by name.
+func NewStringEnumSet(names ...string) (*EnumSet, error) {
+	members := make([]EnumMember, len(names))
+	for i, n := range names {
+		members[i] = EnumMember{Name: n, Ordinal: int64(i)}
+	}
+	s, err := NewEnumSet(members...)
+	if err != nil {
+		return nil, err
+	}
+	s.useOrdinal = false
+	return s, nil
+}
+
+// MustEnumSet is like NewEnumSet but panics if the members are invalid
+func MustEnumSet(members ...EnumMember) *EnumSet {
+	s, err := NewEnumSet(members...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustStringEnumSet is like NewStringEnumSet but panics if the names are invalid
+func MustStringEnumSet(names ...string) *EnumSet {
+	s, err := NewStringEnumSet(names...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Members returns the members in registration order
+func (s *EnumSet) Members() []EnumMember {
+	return append([]EnumMember(nil), s.members...)
+}
+
+// Names returns the names of the members in registration order, e.g. for the enum of an OpenAPI schema
+func (s *EnumSet) Names() []string {
+	names := make([]string, len(s.members))
+	for i, m := range s.members {
+		names[i] = m.Name
+	}
+	return names
+}
+
+// Lookup returns the member with the specified name
+func (s *EnumSet) Lookup(name string) (EnumMember, bool) {
+	i, ok := s.byName[name]
+	if !ok {
+		return EnumMember{}, false
+	}
+	return s.members[i], true
+}
+
+// LookupOrdinal returns the member with the specified ordinal
+func (s *EnumSet) LookupOrdinal(ordinal int64) (EnumMember, bool) {
+	i, ok := s.byOrdinal[ordinal]
+	if !ok {
+		return EnumMember{}, false
+	}
+	return s.members[i], true
+}
+
+// EnumDefinition provides the EnumSet of an Enum type.
+//
+//	var statusSet = generic.MustStringEnumSet("active", "suspended")
+//
+//	type StatusDefinition struct{}
+//
+//	func (StatusDefinition) EnumSet() *generic.EnumSet { return statusSet }
+//
+//	type Status = generic.Enum[StatusDefinition]
+type EnumDefinition interface {
+	EnumSet() *EnumSet
+}
+
+// Enum is generic enum type structure accepting only members of the EnumSet provided by D
+type Enum[D EnumDefinition] struct {
+	ValidFlag
+	member EnumMember
+}
+
+// MarshalEnum return generic.Enum converting of request data
+func MarshalEnum[D EnumDefinition](x interface{}) (Enum[D], error) {
+	v := Enum[D]{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustEnum return generic.Enum converting of request data
+func MustEnum[D EnumDefinition](x interface{}) Enum[D] {
+	v, err := MarshalEnum[D](x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (v Enum[D]) set() *EnumSet {
+	var d D
+	return d.EnumSet()
+}
+
+// Value implements the driver Valuer interface.
+// Members of sets created by NewEnumSet are stored by ordinal, and those of NewStringEnumSet by name.
+// It returns ErrInvalidGenericValue if D provides no EnumSet.
+func (v Enum[D]) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	s := v.set()
+	if s == nil {
+		return nil, ErrInvalidGenericValue{Value: v.member.Name}
+	}
+	if s.useOrdinal {
+		return v.member.Ordinal, nil
+	}
+	return v.member.Name, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be the name or the ordinal of a member.
+func (v *Enum[D]) Scan(x interface{}) (err error) {
+	v.member, v.ValidFlag, err = asEnum(x, v.set())
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns the stored value, but if Enum.ValidFlag is false, returns nil.
+func (v Enum[D]) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *Enum[D]) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Name returns the name of the member
+func (v Enum[D]) Name() string {
+	if !v.Valid() {
+		return ""
+	}
+	return v.member.Name
+}
+
+// Ordinal returns the ordinal of the member
+func (v Enum[D]) Ordinal() int64 {
+	if !v.Valid() {
+		return 0
+	}
+	return v.member.Ordinal
+}
+
+// Members returns all members of the EnumSet provided by D, or nil if D provides no EnumSet
+func (v Enum[D]) Members() []EnumMember {
+	s := v.set()
+	if s == nil {
+		return nil
+	}
+	return s.Members()
+}
+
+// String implements the Stringer interface.
+func (v Enum[D]) String() string {
+	return v.Name()
+}
+
+// Equal reports whether v and x are the same member.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Enum[D]) Equal(x Enum[D]) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.member == x.member
+}
+
+// Compare returns -1, 0 or +1 depending on whether the ordinal of v is less than, equal to or greater than that of x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Enum[D]) Compare(x Enum[D], o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.member.Ordinal < x.member.Ordinal:
+		return -1
+	case v.member.Ordinal > x.member.Ordinal:
+		return 1
+	}
+	return 0
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Enum[D]) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return json.Marshal(v.member.Name)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *Enum[D]) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var in interface{}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	return v.Scan(in)
+}
@@ -0,0 +1,122 @@
+package generic
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+var (
+	testStatusSet   = MustStringEnumSet("active", "suspended", "deleted")
+	testPrioritySet = MustEnumSet(EnumMember{Name: "low", Ordinal: 10}, EnumMember{Name: "high", Ordinal: 20})
+)
+
+type testStatus struct{}
+
+func (testStatus) EnumSet() *EnumSet { return testStatusSet }
+
+type testPriority struct{}
+
+func (testPriority) EnumSet() *EnumSet { return testPrioritySet }
+
+type testNoSet struct{}
+
+func (testNoSet) EnumSet() *EnumSet { return nil }
+
+func TestNewEnumSet(t *testing.T) {
+	if _, err := NewStringEnumSet("a", "a"); err == nil {
+		t.Error("NewStringEnumSet() expected error for duplicate name")
+	}
+	if _, err := NewEnumSet(EnumMember{Name: "a", Ordinal: 1}, EnumMember{Name: "b", Ordinal: 1}); err == nil {
+		t.Error("NewEnumSet() expected error for duplicate ordinal")
+	}
+	if _, err := NewEnumSet(EnumMember{Ordinal: 1}); err == nil {
+		t.Error("NewEnumSet() expected error for empty name")
+	}
+	if got := testStatusSet.Names(); !reflect.DeepEqual(got, []string{"active", "suspended", "deleted"}) {
+		t.Errorf("EnumSet.Names() = %v", got)
+	}
+}
+
+func TestMarshalEnum(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "name", args: "suspended", want: "suspended"},
+		{name: "bytes", args: []byte("deleted"), want: "deleted"},
+		{name: "ordinal", args: 1, want: "suspended"},
+		{name: "ordinal string", args: "2", want: "deleted"},
+		{name: "ordinal float", args: float64(0), want: "active"},
+		{name: "nil", args: nil, want: ""},
+		{name: "unknown name", args: "unknown", wantErr: true},
+		{name: "unknown ordinal", args: 3, wantErr: true},
+		{name: "fractional", args: 1.5, wantErr: true},
+		{name: "bool", args: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalEnum[testStatus](tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalEnum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Name() != tt.want {
+				t.Errorf("MarshalEnum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumValue(t *testing.T) {
+	s := MustEnum[testStatus]("deleted")
+	if dv, _ := s.Value(); dv != "deleted" {
+		t.Errorf("Enum.Value() = %v, want deleted", dv)
+	}
+	p := MustEnum[testPriority]("high")
+	if dv, _ := p.Value(); dv != int64(20) {
+		t.Errorf("Enum.Value() = %v, want 20", dv)
+	}
+	if p.Ordinal() != 20 {
+		t.Errorf("Enum.Ordinal() = %v, want 20", p.Ordinal())
+	}
+	if dv, _ := (Enum[testPriority]{}).Value(); dv != nil {
+		t.Errorf("Enum.Value() = %v, want nil", dv)
+	}
+}
+
+func TestEnumJSON(t *testing.T) {
+	var s struct {
+		Status   Enum[testStatus]   `json:"status"`
+		Priority Enum[testPriority] `json:"priority"`
+		Empty    Enum[testStatus]   `json:"empty"`
+	}
+	if err := json.Unmarshal([]byte(`{"status":"active","priority":10,"empty":null}`), &s); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	b, _ := json.Marshal(s)
+	if string(b) != `{"status":"active","priority":"low","empty":null}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+	if err := json.Unmarshal([]byte(`{"status":"unknown"}`), &s); err == nil {
+		t.Error("json.Unmarshal() expected error for unknown member")
+	}
+	if got := s.Priority.Members(); len(got) != 2 || got[1] != (EnumMember{Name: "high", Ordinal: 20}) {
+		t.Errorf("Enum.Members() = %v", got)
+	}
+}
+
+func TestEnumNilSet(t *testing.T) {
+	if got := (Enum[testNoSet]{}).Members(); got != nil {
+		t.Errorf("Enum.Members() = %v, want nil", got)
+	}
+	var v Enum[testNoSet]
+	if err := v.Scan("active"); err == nil || v.Valid() {
+		t.Errorf("Enum.Scan() = %v, valid %v, want error", err, v.Valid())
+	}
+	v = Enum[testNoSet]{ValidFlag: true, member: EnumMember{Name: "active"}}
+	if _, err := v.Value(); err == nil {
+		t.Error("Enum.Value() expected error")
+	}
+}
@@ -1,8 +1,10 @@
 package generic
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"math"
 	"strconv"
 )
 
@@ -97,3 +99,154 @@ func (v *Float) UnmarshalJSON(data []byte) error {
 	}
 	return v.Scan(in)
 }
+
+// Add returns v + x.
+// If either operand is invalid, Add returns an invalid Float.
+func (v Float) Add(x Float) (Float, error) {
+	if !v.Valid() || !x.Valid() {
+		return Float{}, nil
+	}
+	return checkFloat(v.float+x.float, v.float, x.float)
+}
+
+// Sub returns v - x.
+// If either operand is invalid, Sub returns an invalid Float.
+func (v Float) Sub(x Float) (Float, error) {
+	if !v.Valid() || !x.Valid() {
+		return Float{}, nil
+	}
+	return checkFloat(v.float-x.float, v.float, x.float)
+}
+
+// Mul returns v * x.
+// If either operand is invalid, Mul returns an invalid Float.
+func (v Float) Mul(x Float) (Float, error) {
+	if !v.Valid() || !x.Valid() {
+		return Float{}, nil
+	}
+	return checkFloat(v.float*x.float, v.float, x.float)
+}
+
+// Div returns v / x.
+// If either operand is invalid, Div returns an invalid Float.
+func (v Float) Div(x Float) (Float, error) {
+	if !v.Valid() || !x.Valid() {
+		return Float{}, nil
+	}
+	if x.float == 0 {
+		return Float{}, ErrDivideByZero
+	}
+	return checkFloat(v.float/x.float, v.float, x.float)
+}
+
+// Mod returns the floating-point remainder of v / x. See math.Mod.
+// If either operand is invalid, Mod returns an invalid Float.
+func (v Float) Mod(x Float) (Float, error) {
+	if !v.Valid() || !x.Valid() {
+		return Float{}, nil
+	}
+	if x.float == 0 {
+		return Float{}, ErrDivideByZero
+	}
+	return Float{ValidFlag: true, float: math.Mod(v.float, x.float)}, nil
+}
+
+// Neg returns -v.
+// If v is invalid, Neg returns an invalid Float.
+func (v Float) Neg() (Float, error) {
+	if !v.Valid() {
+		return Float{}, nil
+	}
+	return Float{ValidFlag: true, float: -v.float}, nil
+}
+
+// Abs returns the absolute value of v.
+// If v is invalid, Abs returns an invalid Float.
+func (v Float) Abs() (Float, error) {
+	if !v.Valid() {
+		return Float{}, nil
+	}
+	return Float{ValidFlag: true, float: math.Abs(v.float)}, nil
+}
+
+// checkFloat reports ErrOverflow when finite operands produced an infinite result.
+func checkFloat(r, a, b float64) (Float, error) {
+	if math.IsInf(r, 0) && !math.IsInf(a, 0) && !math.IsInf(b, 0) {
+		return Float{}, ErrOverflow
+	}
+	return Float{ValidFlag: true, float: r}, nil
+}
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+// Two NaN values are equal to each other.
+func (v Float) Equal(x Float) bool {
+	return v.Compare(x, NullsFirst) == 0
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Float) Compare(x Float, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	vNaN, xNaN := math.IsNaN(v.float), math.IsNaN(x.float)
+	switch {
+	case vNaN && xNaN:
+		return 0
+	case vNaN || v.float < x.float:
+		return -1
+	case xNaN || v.float > x.float:
+		return 1
+	}
+	return 0
+}
+
+// Or returns the float64 value, but if Float.ValidFlag is false, returns d.
+func (v Float) Or(d float64) float64 {
+	if !v.Valid() {
+		return d
+	}
+	return v.float
+}
+
+// OrElse returns the float64 value, but if Float.ValidFlag is false, returns the result of f.
+func (v Float) OrElse(f func() float64) float64 {
+	if !v.Valid() {
+		return f()
+	}
+	return v.float
+}
+
+// Ptr returns a pointer to a copy of the float64 value, but if Float.ValidFlag is false, returns nil.
+func (v Float) Ptr() *float64 {
+	if !v.Valid() {
+		return nil
+	}
+	x := v.float
+	return &x
+}
+
+// FloatFromPtr returns generic.Float holding *p, or an invalid Float if p is nil.
+func FloatFromPtr(p *float64) Float {
+	if p == nil {
+		return Float{}
+	}
+	return Float{ValidFlag: true, float: *p}
+}
+
+// NullFloat64 converts v to sql.NullFloat64.
+func (v Float) NullFloat64() sql.NullFloat64 {
+	if !v.Valid() {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: v.float, Valid: true}
+}
+
+// FromNullFloat64 returns generic.Float converting of sql.NullFloat64
+func FromNullFloat64(x sql.NullFloat64) Float {
+	if !x.Valid {
+		return Float{}
+	}
+	return Float{ValidFlag: true, float: x.Float64}
+}
@@ -0,0 +1,233 @@
+package generic
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// FloatRange is generic float range type structure.
+// Each side is inclusive or exclusive, and a side whose bound is invalid or infinite is unbounded.
+// It is stored in PostgreSQL numrange columns.
+type FloatRange struct {
+	ValidFlag
+	r rangeOf[float64]
+}
+
+// newFloatRange returns a range whose infinite bounds are unbounded.
+// It returns ErrInvalidRangeBounds if a bound is NaN.
+func newFloatRange(lower float64, hasLower bool, upper float64, hasUpper bool, bounds string) (rangeOf[float64], error) {
+	if (hasLower && math.IsNaN(lower)) || (hasUpper && math.IsNaN(upper)) {
+		return rangeOf[float64]{}, ErrInvalidRangeBounds
+	}
+	hasLower = hasLower && !math.IsInf(lower, 0)
+	hasUpper = hasUpper && !math.IsInf(upper, 0)
+	return newRange(lower, hasLower, upper, hasUpper, bounds, compareFloat64)
+}
+
+// NewFloatRange returns generic.FloatRange from lower to upper.
+// bounds is one of "[]", "[)", "(]" and "()", where a square bracket makes the side inclusive.
+// An invalid lower or upper makes the side unbounded.
+func NewFloatRange(lower, upper Float, bounds string) (FloatRange, error) {
+	r, err := newFloatRange(lower.float, lower.Valid(), upper.float, upper.Valid(), bounds)
+	if err != nil {
+		return FloatRange{}, err
+	}
+	return FloatRange{ValidFlag: true, r: r}, nil
+}
+
+// MarshalFloatRange return generic.FloatRange converting of request data
+func MarshalFloatRange(x interface{}) (FloatRange, error) {
+	v := FloatRange{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustFloatRange return generic.FloatRange converting of request data
+func MustFloatRange(x interface{}) FloatRange {
+	v, err := MarshalFloatRange(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a PostgreSQL range literal such as `[1.5,10)`.
+func (v FloatRange) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a PostgreSQL numrange literal such as `[1.5,10)`, or a range such as "1.5..10" whose sides are inclusive.
+func (v *FloatRange) Scan(x interface{}) (err error) {
+	v.r, v.ValidFlag, err = asFloatRange(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns the range literal, but if FloatRange.ValidFlag is false, returns nil.
+func (v FloatRange) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *FloatRange) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Lower returns the lower bound, or an invalid Float if the range is unbounded below, empty or invalid.
+func (v FloatRange) Lower() Float {
+	if !v.Valid() || !v.r.hasLower {
+		return Float{}
+	}
+	return Float{ValidFlag: true, float: v.r.lower}
+}
+
+// Upper returns the upper bound, or an invalid Float if the range is unbounded above, empty or invalid.
+func (v FloatRange) Upper() Float {
+	if !v.Valid() || !v.r.hasUpper {
+		return Float{}
+	}
+	return Float{ValidFlag: true, float: v.r.upper}
+}
+
+// Bounds returns the inclusiveness of the sides such as "[)".
+// An unbounded side is always exclusive.
+func (v FloatRange) Bounds() string {
+	return v.r.bounds()
+}
+
+// IsEmpty reports whether the range contains no number
+func (v FloatRange) IsEmpty() bool {
+	return v.Valid() && v.r.empty
+}
+
+// Contains reports whether f is in the range.
+func (v FloatRange) Contains(f float64) bool {
+	return v.Valid() && !math.IsNaN(f) && v.r.contains(f, compareFloat64)
+}
+
+// Overlaps reports whether v and x have any number in common.
+func (v FloatRange) Overlaps(x FloatRange) bool {
+	return v.Valid() && x.Valid() && v.r.overlaps(x.r, compareFloat64)
+}
+
+// Clamp returns the number in the range nearest to f, and false if the range is empty or invalid.
+// An exclusive bound clamps to the adjacent float64 inside the range.
+func (v FloatRange) Clamp(f float64) (float64, bool) {
+	if !v.Valid() || v.r.empty || math.IsNaN(f) {
+		return 0, false
+	}
+	if v.r.hasLower {
+		if c := compareFloat64(f, v.r.lower); c < 0 || (c == 0 && !v.r.lowerInc) {
+			if v.r.lowerInc {
+				return v.r.lower, true
+			}
+			return math.Nextafter(v.r.lower, math.Inf(1)), true
+		}
+	}
+	if v.r.hasUpper {
+		if c := compareFloat64(f, v.r.upper); c > 0 || (c == 0 && !v.r.upperInc) {
+			if v.r.upperInc {
+				return v.r.upper, true
+			}
+			return math.Nextafter(v.r.upper, math.Inf(-1)), true
+		}
+	}
+	return f, true
+}
+
+// Equal reports whether v and x are the same range.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v FloatRange) Equal(x FloatRange) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.r.equal(x.r, compareFloat64)
+}
+
+// String implements the Stringer interface.
+// It returns the PostgreSQL range literal, or an empty string if FloatRange.ValidFlag is false.
+func (v FloatRange) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return formatRangeLiteral(rangeLiteral{
+		lower:    strconv.FormatFloat(v.r.lower, 'f', -1, 64),
+		upper:    strconv.FormatFloat(v.r.upper, 'f', -1, 64),
+		hasLower: v.r.hasLower,
+		hasUpper: v.r.hasUpper,
+		bounds:   v.r.bounds(),
+		empty:    v.r.empty,
+	})
+}
+
+// floatRangeJSON is the JSON form of FloatRange.
+// Bounds is omitted for the default "[)", and Empty is set only for empty ranges.
+type floatRangeJSON struct {
+	Lower  Float  `json:"lower"`
+	Upper  Float  `json:"upper"`
+	Bounds string `json:"bounds,omitempty"`
+	Empty  bool   `json:"empty,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The value is encoded as {"lower":...,"upper":...}, where null is unbounded.
+func (v FloatRange) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	if v.r.empty {
+		return json.Marshal(floatRangeJSON{Empty: true})
+	}
+	j := floatRangeJSON{Lower: v.Lower(), Upper: v.Upper()}
+	if b := v.r.bounds(); b != "[)" {
+		j.Bounds = b
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts {"lower":...,"upper":...} with optional "bounds", which defaults to "[)", and range strings.
+func (v *FloatRange) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil
+	}
+	if data[0] != '{' {
+		var in interface{}
+		if err := json.Unmarshal(data, &in); err != nil {
+			return err
+		}
+		return v.Scan(in)
+	}
+	var in floatRangeJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	if in.Empty {
+		*v = FloatRange{ValidFlag: true, r: rangeOf[float64]{empty: true}}
+		return nil
+	}
+	if in.Bounds == "" {
+		in.Bounds = "[)"
+	}
+	r, err := NewFloatRange(in.Lower, in.Upper, in.Bounds)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	*v = r
+	return nil
+}
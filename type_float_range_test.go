@@ -0,0 +1,111 @@
+package generic
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestFloatRangeScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "numrange", args: "[1.5,10)", want: "[1.5,10)"},
+		{name: "bytes", args: []byte("(0,0.25]"), want: "(0,0.25]"},
+		{name: "dots", args: "-1.5..2", want: "[-1.5,2]"},
+		{name: "unbounded", args: "..2", want: "(,2]"},
+		{name: "infinity", args: "[1,infinity)", want: "[1,)"},
+		{name: "single point", args: "[1,1]", want: "[1,1]"},
+		{name: "empty", args: "[1,1)", want: "empty"},
+		{name: "nil", args: nil},
+		{name: "reversed", args: "[2,1]", wantErr: true},
+		{name: "NaN", args: "[NaN,1]", wantErr: true},
+		{name: "text", args: "[a,1]", wantErr: true},
+		{name: "float", args: 1.5, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalFloatRange(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalFloatRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.String() != tt.want {
+				t.Errorf("FloatRange.String() = %s, want %s", got.String(), tt.want)
+			}
+			if v, _ := got.Value(); tt.want != "" && v != tt.want {
+				t.Errorf("FloatRange.Value() = %v, want %s", v, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloatRangeOperations(t *testing.T) {
+	a, err := NewFloatRange(MustFloat(0), MustFloat(1), "[)")
+	if err != nil {
+		t.Fatalf("NewFloatRange() error = %v", err)
+	}
+	if !a.Contains(0) || !a.Contains(0.5) || a.Contains(1) || a.Contains(math.NaN()) {
+		t.Error("FloatRange.Contains() is wrong")
+	}
+	if !a.Overlaps(MustFloatRange("[0.5,2]")) || a.Overlaps(MustFloatRange("[1,2]")) || !a.Overlaps(MustFloatRange("(,)")) {
+		t.Error("FloatRange.Overlaps() is wrong")
+	}
+	for _, tt := range []struct{ in, want float64 }{{-1, 0}, {0.5, 0.5}, {1, math.Nextafter(1, 0)}, {5, math.Nextafter(1, 0)}} {
+		if got, ok := a.Clamp(tt.in); !ok || got != tt.want {
+			t.Errorf("FloatRange.Clamp(%v) = %v, %v, want %v", tt.in, got, ok, tt.want)
+		}
+	}
+	if got, ok := MustFloatRange("(0,)").Clamp(-1); !ok || got != math.Nextafter(0, 1) {
+		t.Errorf("FloatRange.Clamp() = %v, %v", got, ok)
+	}
+	if _, ok := MustFloatRange("empty").Clamp(1); ok {
+		t.Error("FloatRange.Clamp() of empty range should not be ok")
+	}
+	if !a.Equal(MustFloatRange("[0,1)")) || a.Equal(MustFloatRange("[0,1]")) {
+		t.Error("FloatRange.Equal() is wrong")
+	}
+	if r, err := NewFloatRange(MustFloat(math.Inf(-1)), MustFloat(1), "[]"); err != nil || r.Lower().Valid() || r.String() != "(,1]" {
+		t.Errorf("NewFloatRange() = %s, %v", r, err)
+	}
+	if _, err := NewFloatRange(MustFloat(math.NaN()), MustFloat(1), "[]"); err != ErrInvalidRangeBounds {
+		t.Errorf("NewFloatRange() error = %v, want ErrInvalidRangeBounds", err)
+	}
+}
+
+func TestFloatRangeJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{data: `{"lower":1.5,"upper":10}`, want: `{"lower":1.5,"upper":10}`},
+		{data: `{"lower":0,"upper":1,"bounds":"(]"}`, want: `{"lower":0,"upper":1,"bounds":"(]"}`},
+		{data: `{"lower":0,"upper":null}`, want: `{"lower":0,"upper":null}`},
+		{data: `{"empty":true}`, want: `{"lower":null,"upper":null,"empty":true}`},
+		{data: `"0.5..1"`, want: `{"lower":0.5,"upper":1,"bounds":"[]"}`},
+		{data: `null`, want: `null`},
+		{data: `{"lower":2,"upper":1}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var v FloatRange
+			err := json.Unmarshal([]byte(tt.data), &v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FloatRange.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if v.Valid() {
+					t.Error("FloatRange.UnmarshalJSON() should leave an invalid value on error")
+				}
+				return
+			}
+			b, err := json.Marshal(v)
+			if err != nil || string(b) != tt.want {
+				t.Errorf("FloatRange.MarshalJSON() = %s, %v, want %s", b, err, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package generic
 
 import (
 	"encoding/json"
+	"math"
 	"reflect"
 	"testing"
 
@@ -237,3 +238,67 @@ func TestFloatStringInvalid(t *testing.T) {
 		t.Errorf("expected empty string, actual:%s", tf.String())
 	}
 }
+
+func TestFloatArithmetic(t *testing.T) {
+	f := func(n float64) Float { return Float{ValidFlag: true, float: n} }
+	tests := []struct {
+		name    string
+		f       func() (Float, error)
+		want    Float
+		wantErr error
+	}{
+		{name: "add", f: func() (Float, error) { return f(1.5).Add(f(2)) }, want: f(3.5)},
+		{name: "add invalid", f: func() (Float, error) { return f(1.5).Add(Float{}) }, want: Float{}},
+		{name: "add overflow", f: func() (Float, error) { return f(math.MaxFloat64).Add(f(math.MaxFloat64)) }, wantErr: ErrOverflow},
+		{name: "add infinity", f: func() (Float, error) { return f(math.Inf(1)).Add(f(1)) }, want: f(math.Inf(1))},
+		{name: "sub", f: func() (Float, error) { return f(1).Sub(f(2.5)) }, want: f(-1.5)},
+		{name: "mul", f: func() (Float, error) { return f(1.5).Mul(f(-2)) }, want: f(-3)},
+		{name: "mul overflow", f: func() (Float, error) { return f(math.MaxFloat64).Mul(f(2)) }, wantErr: ErrOverflow},
+		{name: "div", f: func() (Float, error) { return f(3).Div(f(2)) }, want: f(1.5)},
+		{name: "div by zero", f: func() (Float, error) { return f(3).Div(f(0)) }, wantErr: ErrDivideByZero},
+		{name: "mod", f: func() (Float, error) { return f(7.5).Mod(f(2)) }, want: f(1.5)},
+		{name: "mod by zero", f: func() (Float, error) { return f(7.5).Mod(f(0)) }, wantErr: ErrDivideByZero},
+		{name: "neg", f: func() (Float, error) { return f(1.5).Neg() }, want: f(-1.5)},
+		{name: "abs", f: func() (Float, error) { return f(-1.5).Abs() }, want: f(1.5)},
+		{name: "abs invalid", f: func() (Float, error) { return Float{}.Abs() }, want: Float{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f()
+			if err != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloatCompare(t *testing.T) {
+	f := func(n float64) Float { return Float{ValidFlag: true, float: n} }
+	tests := []struct {
+		name string
+		v    Float
+		x    Float
+		want int
+	}{
+		{name: "less", v: f(1), x: f(2), want: -1},
+		{name: "greater", v: f(2), x: f(1), want: 1},
+		{name: "equal", v: f(1), x: f(1), want: 0},
+		{name: "NaN before numbers", v: f(math.NaN()), x: f(math.Inf(-1)), want: -1},
+		{name: "NaN equals NaN", v: f(math.NaN()), x: f(math.NaN()), want: 0},
+		{name: "invalid before NaN", v: Float{}, x: f(math.NaN()), want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Compare(tt.x, NullsFirst); got != tt.want {
+				t.Errorf("Float.Compare() = %v, want %v", got, tt.want)
+			}
+			if got := tt.v.Equal(tt.x); got != (tt.want == 0) {
+				t.Errorf("Float.Equal() = %v, want %v", got, tt.want == 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package generic
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// TimeFormat provides the layout and location of a FormattedTime type.
+//
+//	type SlashMinute struct{}
+//
+//	func (SlashMinute) Layout() string { return "2006/01/02 15:04" }
+//
+//	func (SlashMinute) Location() *time.Location { return time.UTC }
+//
+//	type PostedAt = generic.FormattedTime[SlashMinute]
+type TimeFormat interface {
+	// Layout returns the layout used for formatting and parsing, as in time.Format.
+	Layout() string
+
+	// Location returns the location that values are formatted in, and that zone-less inputs are parsed in.
+	// If nil, values are formatted in their own location and inputs are parsed in UTC.
+	Location() *time.Location
+}
+
+// FormattedTime is generic time type structure whose text form uses the layout provided by F
+type FormattedTime[F TimeFormat] struct {
+	ValidFlag
+	time time.Time
+}
+
+// MarshalFormattedTime return generic.FormattedTime converting of request data
+func MarshalFormattedTime[F TimeFormat](x interface{}) (FormattedTime[F], error) {
+	v := FormattedTime[F]{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustFormattedTime return generic.FormattedTime converting of request data
+func MustFormattedTime[F TimeFormat](x interface{}) FormattedTime[F] {
+	v, err := MarshalFormattedTime[F](x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (v FormattedTime[F]) options() TimeParseOptions {
+	var f F
+	return TimeParseOptions{Layouts: []string{f.Layout()}, Location: f.Location()}
+}
+
+// Value implements the driver Valuer interface.
+func (v FormattedTime[F]) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.time, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Strings and []byte are parsed with the layout provided by F.
+func (v *FormattedTime[F]) Scan(x interface{}) (err error) {
+	v.time, v.ValidFlag, err = asTimeWithOptions(x, v.options())
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns time.Time, but if FormattedTime.ValidFlag is false, returns nil.
+func (v FormattedTime[F]) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *FormattedTime[F]) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Time returns value as time.Time
+func (v FormattedTime[F]) Time() time.Time {
+	if !v.Valid() {
+		return time.Unix(0, 0)
+	}
+	return v.time
+}
+
+// String implements the Stringer interface.
+// It returns the value formatted with the layout provided by F.
+func (v FormattedTime[F]) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	var f F
+	t := v.time
+	if loc := f.Location(); loc != nil {
+		t = t.In(loc)
+	}
+	return t.Format(f.Layout())
+}
+
+// Equal reports whether v and x are the same instant.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v FormattedTime[F]) Equal(x FormattedTime[F]) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.time.Equal(x.time)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v FormattedTime[F]) Compare(x FormattedTime[F], o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.time.Before(x.time):
+		return -1
+	case v.time.After(x.time):
+		return 1
+	}
+	return 0
+}
+
+// Since returns the time elapsed since v by DefaultClock, or 0 if v is invalid.
+func (v FormattedTime[F]) Since() time.Duration {
+	return sinceClock(v.time, v.ValidFlag)
+}
+
+// IsPast reports whether v is valid and before the current time of DefaultClock.
+func (v FormattedTime[F]) IsPast() bool {
+	return isPast(v.time, v.ValidFlag)
+}
+
+// IsFuture reports whether v is valid and after the current time of DefaultClock.
+func (v FormattedTime[F]) IsFuture() bool {
+	return isFuture(v.time, v.ValidFlag)
+}
+
+// ValidateWithin returns ErrTimeOutOfRange unless v is within past and future of DefaultClock,
+// and ErrInvalidGenericValue if v is invalid, in the same way as Time.ValidateWithin.
+func (v FormattedTime[F]) ValidateWithin(past, future time.Duration) error {
+	return validateWithin(v.time, v.ValidFlag, past, future)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v FormattedTime[F]) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *FormattedTime[F]) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var in interface{}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	return v.Scan(in)
+}
@@ -0,0 +1,72 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type slashMinuteJST struct{}
+
+func (slashMinuteJST) Layout() string { return "2006/01/02 15:04" }
+
+func (slashMinuteJST) Location() *time.Location { return time.FixedZone("JST", 9*60*60) }
+
+type rfc1123 struct{}
+
+func (rfc1123) Layout() string { return time.RFC1123 }
+
+func (rfc1123) Location() *time.Location { return nil }
+
+func TestFormattedTimeJSON(t *testing.T) {
+	type row struct {
+		Posted FormattedTime[slashMinuteJST] `json:"posted"`
+		Header FormattedTime[rfc1123]        `json:"header"`
+	}
+	var r row
+	if err := json.Unmarshal([]byte(`{"posted":"2024/01/02 09:30","header":"Tue, 02 Jan 2024 00:30:00 UTC"}`), &r); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	want := time.Date(2024, 1, 2, 0, 30, 0, 0, time.UTC)
+	if !r.Posted.Time().Equal(want) {
+		t.Errorf("FormattedTime.UnmarshalJSON() = %v, want %v", r.Posted.Time(), want)
+	}
+	if !r.Header.Time().Equal(want) {
+		t.Errorf("FormattedTime.UnmarshalJSON() = %v, want %v", r.Header.Time(), want)
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(b) != `{"posted":"2024/01/02 09:30","header":"Tue, 02 Jan 2024 00:30:00 UTC"}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+}
+
+func TestFormattedTimeScan(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 0, 30, 0, 0, time.UTC)
+	v, err := MarshalFormattedTime[slashMinuteJST](ts)
+	if err != nil || v.String() != "2024/01/02 09:30" {
+		t.Errorf("MarshalFormattedTime() = %v, %v", v, err)
+	}
+	if got, _ := v.Value(); got != ts {
+		t.Errorf("FormattedTime.Value() = %v, want %v", got, ts)
+	}
+	if _, err = MarshalFormattedTime[slashMinuteJST]("2024-01-02T00:30:00Z"); err == nil {
+		t.Error("MarshalFormattedTime() expected error for another layout")
+	}
+	if err = v.Scan(nil); err != nil || v.Valid() {
+		t.Errorf("FormattedTime.Scan(nil) = %v, %v", v, err)
+	}
+	b, _ := json.Marshal(v)
+	if string(b) != "null" {
+		t.Errorf("FormattedTime.MarshalJSON() = %s, want null", b)
+	}
+	w := MustFormattedTime[slashMinuteJST](MustTime(ts))
+	if !w.Equal(MustFormattedTime[slashMinuteJST]([]byte("2024/01/02 09:30"))) {
+		t.Error("FormattedTime.Equal() = false, want true")
+	}
+	if w.Compare(FormattedTime[slashMinuteJST]{}, NullsFirst) != 1 {
+		t.Error("FormattedTime.Compare() should order invalid values first")
+	}
+}
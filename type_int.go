@@ -1,8 +1,10 @@
 package generic
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"math"
 	"strconv"
 )
 
@@ -108,3 +110,166 @@ func (v *Int) UnmarshalJSON(data []byte) error {
 	}
 	return v.Scan(in)
 }
+
+// Add returns v + x.
+// If either operand is invalid, Add returns an invalid Int.
+func (v Int) Add(x Int) (Int, error) {
+	if !v.Valid() || !x.Valid() {
+		return Int{}, nil
+	}
+	r := v.int + x.int
+	if (r > v.int) != (x.int > 0) {
+		return Int{}, ErrOverflow
+	}
+	return Int{ValidFlag: true, int: r}, nil
+}
+
+// Sub returns v - x.
+// If either operand is invalid, Sub returns an invalid Int.
+func (v Int) Sub(x Int) (Int, error) {
+	if !v.Valid() || !x.Valid() {
+		return Int{}, nil
+	}
+	r := v.int - x.int
+	if (r < v.int) != (x.int > 0) {
+		return Int{}, ErrOverflow
+	}
+	return Int{ValidFlag: true, int: r}, nil
+}
+
+// Mul returns v * x.
+// If either operand is invalid, Mul returns an invalid Int.
+func (v Int) Mul(x Int) (Int, error) {
+	if !v.Valid() || !x.Valid() {
+		return Int{}, nil
+	}
+	if v.int == 0 || x.int == 0 {
+		return Int{ValidFlag: true, int: 0}, nil
+	}
+	r := v.int * x.int
+	if (v.int == -1 && x.int == math.MinInt64) || (x.int == -1 && v.int == math.MinInt64) || r/x.int != v.int {
+		return Int{}, ErrOverflow
+	}
+	return Int{ValidFlag: true, int: r}, nil
+}
+
+// Div returns v / x truncated toward zero.
+// If either operand is invalid, Div returns an invalid Int.
+func (v Int) Div(x Int) (Int, error) {
+	if !v.Valid() || !x.Valid() {
+		return Int{}, nil
+	}
+	if x.int == 0 {
+		return Int{}, ErrDivideByZero
+	}
+	if v.int == math.MinInt64 && x.int == -1 {
+		return Int{}, ErrOverflow
+	}
+	return Int{ValidFlag: true, int: v.int / x.int}, nil
+}
+
+// Mod returns the remainder of v / x.
+// If either operand is invalid, Mod returns an invalid Int.
+func (v Int) Mod(x Int) (Int, error) {
+	if !v.Valid() || !x.Valid() {
+		return Int{}, nil
+	}
+	if x.int == 0 {
+		return Int{}, ErrDivideByZero
+	}
+	return Int{ValidFlag: true, int: v.int % x.int}, nil
+}
+
+// Neg returns -v.
+// If v is invalid, Neg returns an invalid Int.
+func (v Int) Neg() (Int, error) {
+	if !v.Valid() {
+		return Int{}, nil
+	}
+	if v.int == math.MinInt64 {
+		return Int{}, ErrOverflow
+	}
+	return Int{ValidFlag: true, int: -v.int}, nil
+}
+
+// Abs returns the absolute value of v.
+// If v is invalid, Abs returns an invalid Int.
+func (v Int) Abs() (Int, error) {
+	if v.Valid() && v.int < 0 {
+		return v.Neg()
+	}
+	return v, nil
+}
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Int) Equal(x Int) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.int == x.int
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Int) Compare(x Int, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.int < x.int:
+		return -1
+	case v.int > x.int:
+		return 1
+	}
+	return 0
+}
+
+// Or returns the int64 value, but if Int.ValidFlag is false, returns d.
+func (v Int) Or(d int64) int64 {
+	if !v.Valid() {
+		return d
+	}
+	return v.int
+}
+
+// OrElse returns the int64 value, but if Int.ValidFlag is false, returns the result of f.
+func (v Int) OrElse(f func() int64) int64 {
+	if !v.Valid() {
+		return f()
+	}
+	return v.int
+}
+
+// Ptr returns a pointer to a copy of the int64 value, but if Int.ValidFlag is false, returns nil.
+func (v Int) Ptr() *int64 {
+	if !v.Valid() {
+		return nil
+	}
+	x := v.int
+	return &x
+}
+
+// IntFromPtr returns generic.Int holding *p, or an invalid Int if p is nil.
+func IntFromPtr(p *int64) Int {
+	if p == nil {
+		return Int{}
+	}
+	return Int{ValidFlag: true, int: *p}
+}
+
+// NullInt64 converts v to sql.NullInt64.
+func (v Int) NullInt64() sql.NullInt64 {
+	if !v.Valid() {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: v.int, Valid: true}
+}
+
+// FromNullInt64 returns generic.Int converting of sql.NullInt64
+func FromNullInt64(x sql.NullInt64) Int {
+	if !x.Valid {
+		return Int{}
+	}
+	return Int{ValidFlag: true, int: x.Int64}
+}
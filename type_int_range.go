@@ -0,0 +1,235 @@
+package generic
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// IntRange is generic integer range type structure.
+// Like PostgreSQL int8range, it is kept in the canonical form "[)", and a side whose bound is invalid is unbounded.
+type IntRange struct {
+	ValidFlag
+	r rangeOf[int64]
+}
+
+// newIntRange returns a range converted to the canonical form "[)".
+// It returns ErrInvalidRangeBounds if the canonical bound would overflow int64.
+func newIntRange(lower int64, hasLower bool, upper int64, hasUpper bool, bounds string) (rangeOf[int64], error) {
+	r, err := newRange(lower, hasLower, upper, hasUpper, bounds, compareInt64)
+	if err != nil || r.empty {
+		return r, err
+	}
+	if r.hasLower && !r.lowerInc {
+		if r.lower == math.MaxInt64 {
+			return rangeOf[int64]{}, ErrInvalidRangeBounds
+		}
+		r.lower, r.lowerInc = r.lower+1, true
+	}
+	if r.hasUpper && r.upperInc {
+		if r.upper == math.MaxInt64 {
+			return rangeOf[int64]{}, ErrInvalidRangeBounds
+		}
+		r.upper, r.upperInc = r.upper+1, false
+	}
+	if r.hasLower && r.hasUpper && r.lower >= r.upper {
+		return rangeOf[int64]{empty: true}, nil
+	}
+	return r, nil
+}
+
+// NewIntRange returns generic.IntRange from lower to upper.
+// bounds is one of "[]", "[)", "(]" and "()", where a square bracket makes the side inclusive.
+// An invalid lower or upper makes the side unbounded.
+func NewIntRange(lower, upper Int, bounds string) (IntRange, error) {
+	r, err := newIntRange(lower.int, lower.Valid(), upper.int, upper.Valid(), bounds)
+	if err != nil {
+		return IntRange{}, err
+	}
+	return IntRange{ValidFlag: true, r: r}, nil
+}
+
+// MarshalIntRange return generic.IntRange converting of request data
+func MarshalIntRange(x interface{}) (IntRange, error) {
+	v := IntRange{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustIntRange return generic.IntRange converting of request data
+func MustIntRange(x interface{}) IntRange {
+	v, err := MarshalIntRange(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a PostgreSQL range literal such as `[1,10)`.
+func (v IntRange) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a PostgreSQL int8range literal such as `[1,10)`, or a range such as "1..10" whose sides are inclusive.
+func (v *IntRange) Scan(x interface{}) (err error) {
+	v.r, v.ValidFlag, err = asIntRange(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns the range literal, but if IntRange.ValidFlag is false, returns nil.
+func (v IntRange) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *IntRange) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Lower returns the inclusive lower bound, or an invalid Int if the range is unbounded below, empty or invalid.
+func (v IntRange) Lower() Int {
+	if !v.Valid() || !v.r.hasLower {
+		return Int{}
+	}
+	return Int{ValidFlag: true, int: v.r.lower}
+}
+
+// Upper returns the exclusive upper bound, or an invalid Int if the range is unbounded above, empty or invalid.
+func (v IntRange) Upper() Int {
+	if !v.Valid() || !v.r.hasUpper {
+		return Int{}
+	}
+	return Int{ValidFlag: true, int: v.r.upper}
+}
+
+// Bounds returns the inclusiveness of the sides such as "[)".
+// An unbounded side is always exclusive.
+func (v IntRange) Bounds() string {
+	return v.r.bounds()
+}
+
+// IsEmpty reports whether the range contains no integer
+func (v IntRange) IsEmpty() bool {
+	return v.Valid() && v.r.empty
+}
+
+// Contains reports whether i is in the range.
+func (v IntRange) Contains(i int64) bool {
+	return v.Valid() && v.r.contains(i, compareInt64)
+}
+
+// Overlaps reports whether v and x have any integer in common.
+func (v IntRange) Overlaps(x IntRange) bool {
+	return v.Valid() && x.Valid() && v.r.overlaps(x.r, compareInt64)
+}
+
+// Clamp returns the integer in the range nearest to i, and false if the range is empty or invalid.
+func (v IntRange) Clamp(i int64) (int64, bool) {
+	if !v.Valid() || v.r.empty {
+		return 0, false
+	}
+	if v.r.hasLower && i < v.r.lower {
+		return v.r.lower, true
+	}
+	if v.r.hasUpper && i >= v.r.upper {
+		return v.r.upper - 1, true
+	}
+	return i, true
+}
+
+// Equal reports whether v and x are the same range.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v IntRange) Equal(x IntRange) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.r.equal(x.r, compareInt64)
+}
+
+// String implements the Stringer interface.
+// It returns the PostgreSQL range literal, or an empty string if IntRange.ValidFlag is false.
+func (v IntRange) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return formatRangeLiteral(rangeLiteral{
+		lower:    strconv.FormatInt(v.r.lower, 10),
+		upper:    strconv.FormatInt(v.r.upper, 10),
+		hasLower: v.r.hasLower,
+		hasUpper: v.r.hasUpper,
+		bounds:   v.r.bounds(),
+		empty:    v.r.empty,
+	})
+}
+
+// intRangeJSON is the JSON form of IntRange.
+// Bounds is omitted for the default "[)", and Empty is set only for empty ranges.
+type intRangeJSON struct {
+	Lower  Int    `json:"lower"`
+	Upper  Int    `json:"upper"`
+	Bounds string `json:"bounds,omitempty"`
+	Empty  bool   `json:"empty,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The value is encoded as {"lower":...,"upper":...}, where null is unbounded.
+func (v IntRange) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	if v.r.empty {
+		return json.Marshal(intRangeJSON{Empty: true})
+	}
+	j := intRangeJSON{Lower: v.Lower(), Upper: v.Upper()}
+	if b := v.r.bounds(); b != "[)" {
+		j.Bounds = b
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts {"lower":...,"upper":...} with optional "bounds", which defaults to "[)", and range strings.
+func (v *IntRange) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil
+	}
+	if data[0] != '{' {
+		var in interface{}
+		if err := json.Unmarshal(data, &in); err != nil {
+			return err
+		}
+		return v.Scan(in)
+	}
+	var in intRangeJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	if in.Empty {
+		*v = IntRange{ValidFlag: true, r: rangeOf[int64]{empty: true}}
+		return nil
+	}
+	if in.Bounds == "" {
+		in.Bounds = "[)"
+	}
+	r, err := NewIntRange(in.Lower, in.Upper, in.Bounds)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	*v = r
+	return nil
+}
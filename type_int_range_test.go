@@ -0,0 +1,121 @@
+package generic
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestIntRangeScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "int8range", args: "[1,10)", want: "[1,10)"},
+		{name: "canonical", args: "(0,9]", want: "[1,10)"},
+		{name: "bytes", args: []byte("[1,10]"), want: "[1,11)"},
+		{name: "dots", args: "1..10", want: "[1,11)"},
+		{name: "unbounded below", args: "..10", want: "(,11)"},
+		{name: "unbounded above", args: "(5,)", want: "[6,)"},
+		{name: "unbounded", args: "(,)", want: "(,)"},
+		{name: "empty", args: "empty", want: "empty"},
+		{name: "empty after canonicalization", args: "(1,2)", want: "empty"},
+		{name: "nil", args: nil},
+		{name: "reversed", args: "[10,1)", wantErr: true},
+		{name: "float", args: "[1.5,2)", wantErr: true},
+		{name: "overflow", args: "[1,9223372036854775807]", wantErr: true},
+		{name: "int", args: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalIntRange(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalIntRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.String() != tt.want {
+				t.Errorf("IntRange.String() = %s, want %s", got.String(), tt.want)
+			}
+			if v, _ := got.Value(); tt.want != "" && v != tt.want {
+				t.Errorf("IntRange.Value() = %v, want %s", v, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntRangeOperations(t *testing.T) {
+	a, err := NewIntRange(MustInt(1), MustInt(10), "[]")
+	if err != nil {
+		t.Fatalf("NewIntRange() error = %v", err)
+	}
+	if got := a.Lower(); got.Int64() != 1 {
+		t.Errorf("IntRange.Lower() = %v, want 1", got)
+	}
+	if got := a.Upper(); got.Int64() != 11 {
+		t.Errorf("IntRange.Upper() = %v, want 11", got)
+	}
+	if !a.Contains(1) || !a.Contains(10) || a.Contains(11) || a.Contains(0) {
+		t.Error("IntRange.Contains() is wrong")
+	}
+	if !a.Overlaps(MustIntRange("[10,20)")) || a.Overlaps(MustIntRange("[11,20)")) || a.Overlaps(MustIntRange("empty")) {
+		t.Error("IntRange.Overlaps() is wrong")
+	}
+	for _, tt := range []struct{ in, want int64 }{{-5, 1}, {5, 5}, {10, 10}, {math.MaxInt64, 10}} {
+		if got, ok := a.Clamp(tt.in); !ok || got != tt.want {
+			t.Errorf("IntRange.Clamp(%d) = %d, %v, want %d", tt.in, got, ok, tt.want)
+		}
+	}
+	if got, ok := MustIntRange("..0").Clamp(5); !ok || got != 0 {
+		t.Errorf("IntRange.Clamp() = %d, %v, want 0", got, ok)
+	}
+	if _, ok := MustIntRange("empty").Clamp(5); ok {
+		t.Error("IntRange.Clamp() of empty range should not be ok")
+	}
+	if !a.Equal(MustIntRange("(0,11)")) || a.Equal(MustIntRange("[1,10)")) || a.Equal(IntRange{}) {
+		t.Error("IntRange.Equal() is wrong")
+	}
+	if u, err := NewIntRange(Int{}, MustInt(3), "[]"); err != nil || u.String() != "(,4)" || u.Lower().Valid() {
+		t.Errorf("NewIntRange() = %s, %v", u, err)
+	}
+	if _, err := NewIntRange(MustInt(1), MustInt(2), "<>"); err != ErrInvalidRangeBounds {
+		t.Errorf("NewIntRange() error = %v, want ErrInvalidRangeBounds", err)
+	}
+}
+
+func TestIntRangeJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{data: `{"lower":1,"upper":10}`, want: `{"lower":1,"upper":10}`},
+		{data: `{"lower":"1","upper":10,"bounds":"[]"}`, want: `{"lower":1,"upper":11}`},
+		{data: `{"lower":null,"upper":10}`, want: `{"lower":null,"upper":10,"bounds":"()"}`},
+		{data: `{"upper":10}`, want: `{"lower":null,"upper":10,"bounds":"()"}`},
+		{data: `{"empty":true}`, want: `{"lower":null,"upper":null,"empty":true}`},
+		{data: `"1..10"`, want: `{"lower":1,"upper":11}`},
+		{data: `null`, want: `null`},
+		{data: `{"lower":10,"upper":1}`, wantErr: true},
+		{data: `{"lower":1,"upper":10,"bounds":"<>"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var v IntRange
+			err := json.Unmarshal([]byte(tt.data), &v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IntRange.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if v.Valid() {
+					t.Error("IntRange.UnmarshalJSON() should leave an invalid value on error")
+				}
+				return
+			}
+			b, err := json.Marshal(v)
+			if err != nil || string(b) != tt.want {
+				t.Errorf("IntRange.MarshalJSON() = %s, %v, want %s", b, err, tt.want)
+			}
+		})
+	}
+}
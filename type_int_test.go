@@ -1,7 +1,9 @@
 package generic
 
 import (
+	"database/sql"
 	"encoding/json"
+	"math"
 	"reflect"
 	"testing"
 
@@ -346,3 +348,100 @@ func TestIntStringInvalid(t *testing.T) {
 		t.Errorf("expected empty string, actual:%s", ti.String())
 	}
 }
+
+func TestIntArithmetic(t *testing.T) {
+	i := func(n int64) Int { return Int{ValidFlag: true, int: n} }
+	tests := []struct {
+		name    string
+		f       func() (Int, error)
+		want    Int
+		wantErr error
+	}{
+		{name: "add", f: func() (Int, error) { return i(1).Add(i(2)) }, want: i(3)},
+		{name: "add invalid", f: func() (Int, error) { return i(1).Add(Int{}) }, want: Int{}},
+		{name: "add overflow", f: func() (Int, error) { return i(math.MaxInt64).Add(i(1)) }, wantErr: ErrOverflow},
+		{name: "add negative overflow", f: func() (Int, error) { return i(math.MinInt64).Add(i(-1)) }, wantErr: ErrOverflow},
+		{name: "sub", f: func() (Int, error) { return i(1).Sub(i(3)) }, want: i(-2)},
+		{name: "sub overflow", f: func() (Int, error) { return i(math.MinInt64).Sub(i(1)) }, wantErr: ErrOverflow},
+		{name: "mul", f: func() (Int, error) { return i(-4).Mul(i(3)) }, want: i(-12)},
+		{name: "mul overflow", f: func() (Int, error) { return i(math.MaxInt64 / 2).Mul(i(3)) }, wantErr: ErrOverflow},
+		{name: "mul min by -1", f: func() (Int, error) { return i(math.MinInt64).Mul(i(-1)) }, wantErr: ErrOverflow},
+		{name: "div", f: func() (Int, error) { return i(7).Div(i(2)) }, want: i(3)},
+		{name: "div by zero", f: func() (Int, error) { return i(7).Div(i(0)) }, wantErr: ErrDivideByZero},
+		{name: "div overflow", f: func() (Int, error) { return i(math.MinInt64).Div(i(-1)) }, wantErr: ErrOverflow},
+		{name: "div invalid by zero", f: func() (Int, error) { return Int{}.Div(i(0)) }, want: Int{}},
+		{name: "mod", f: func() (Int, error) { return i(-7).Mod(i(2)) }, want: i(-1)},
+		{name: "mod by zero", f: func() (Int, error) { return i(7).Mod(i(0)) }, wantErr: ErrDivideByZero},
+		{name: "neg", f: func() (Int, error) { return i(5).Neg() }, want: i(-5)},
+		{name: "neg overflow", f: func() (Int, error) { return i(math.MinInt64).Neg() }, wantErr: ErrOverflow},
+		{name: "neg invalid", f: func() (Int, error) { return Int{}.Neg() }, want: Int{}},
+		{name: "abs", f: func() (Int, error) { return i(-5).Abs() }, want: i(5)},
+		{name: "abs overflow", f: func() (Int, error) { return i(math.MinInt64).Abs() }, wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f()
+			if err != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntOr(t *testing.T) {
+	if got := MustInt(1).Or(42); got != 1 {
+		t.Errorf("Int.Or() = %v, want 1", got)
+	}
+	if got := (Int{int: 1}).Or(42); got != 42 {
+		t.Errorf("Int.Or() = %v, want 42", got)
+	}
+	if got := (Int{}).OrElse(func() int64 { return 7 }); got != 7 {
+		t.Errorf("Int.OrElse() = %v, want 7", got)
+	}
+}
+
+func TestIntPtr(t *testing.T) {
+	if got := (Int{}).Ptr(); got != nil {
+		t.Errorf("Int.Ptr() = %v, want nil", got)
+	}
+	v := MustInt(10)
+	p := v.Ptr()
+	if p == nil || *p != 10 {
+		t.Fatalf("Int.Ptr() = %v, want pointer to 10", p)
+	}
+	if got := IntFromPtr(p); got != v {
+		t.Errorf("IntFromPtr() = %v, want %v", got, v)
+	}
+	if got := IntFromPtr(nil); got.Valid() {
+		t.Errorf("IntFromPtr(nil) = %v, want invalid", got)
+	}
+}
+
+func TestIntNullInt64(t *testing.T) {
+	if got := MustInt(10).NullInt64(); got != (sql.NullInt64{Int64: 10, Valid: true}) {
+		t.Errorf("Int.NullInt64() = %v", got)
+	}
+	if got := (Int{int: 10}).NullInt64(); got != (sql.NullInt64{}) {
+		t.Errorf("Int.NullInt64() = %v, want invalid", got)
+	}
+	if got := FromNullInt64(sql.NullInt64{Int64: 10, Valid: true}); got != MustInt(10) {
+		t.Errorf("FromNullInt64() = %v", got)
+	}
+	if got := FromNullInt64(sql.NullInt64{Int64: 10}); got.Valid() {
+		t.Errorf("FromNullInt64() = %v, want invalid", got)
+	}
+}
+
+func TestIntScanNullString(t *testing.T) {
+	var v Int
+	if err := v.Scan(sql.NullString{String: "12", Valid: true}); err != nil {
+		t.Fatalf("Int.Scan() error = %v", err)
+	}
+	if v != MustInt(12) {
+		t.Errorf("Int.Scan() = %v, want 12", v)
+	}
+}
@@ -0,0 +1,154 @@
+package generic
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/netip"
+)
+
+// IP is generic netip.Addr type structure
+type IP struct {
+	ValidFlag
+	ip netip.Addr
+}
+
+// MarshalIP return generic.IP converting of request data
+func MarshalIP(x interface{}) (IP, error) {
+	v := IP{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustIP return generic.IP converting of request data
+func MustIP(x interface{}) IP {
+	v, err := MarshalIP(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is a string accepted by PostgreSQL inet columns.
+func (v IP) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.ip.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a string, a 4 or 16 byte binary address, net.IP or netip.Addr.
+func (v *IP) Scan(x interface{}) (err error) {
+	v.ip, v.ValidFlag, err = asIP(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns netip.Addr, but if IP.ValidFlag is false, returns nil.
+func (v IP) Weak() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	return v.ip
+}
+
+// Set sets a specified value.
+func (v *IP) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Addr returns netip.Addr, but if IP.ValidFlag is false, returns the zero netip.Addr.
+func (v IP) Addr() netip.Addr {
+	if !v.Valid() {
+		return netip.Addr{}
+	}
+	return v.ip
+}
+
+// String implements the Stringer interface.
+func (v IP) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return v.ip.String()
+}
+
+// Is4 reports whether v is an IPv4 address.
+func (v IP) Is4() bool {
+	return v.Valid() && v.ip.Is4()
+}
+
+// Is6 reports whether v is an IPv6 address, including IPv4-mapped IPv6 addresses.
+func (v IP) Is6() bool {
+	return v.Valid() && v.ip.Is6()
+}
+
+// IsPrivate reports whether v is a private address, according to RFC 1918 (IPv4) and RFC 4193 (IPv6).
+func (v IP) IsPrivate() bool {
+	return v.Valid() && v.ip.IsPrivate()
+}
+
+// IsLoopback reports whether v is a loopback address.
+func (v IP) IsLoopback() bool {
+	return v.Valid() && v.ip.IsLoopback()
+}
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v IP) Equal(x IP) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.ip == x.ip
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v IP) Compare(x IP, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	return v.ip.Compare(x.ip)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v IP) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return []byte{}, nil
+	}
+	return v.ip.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// An empty text resets v.
+func (v *IP) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		v.Reset()
+		return nil
+	}
+	return v.Scan(string(text))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v IP) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return json.Marshal(v.ip.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *IP) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var in interface{}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	return v.Scan(in)
+}
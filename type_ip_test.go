@@ -0,0 +1,83 @@
+package generic
+
+import (
+	"encoding/json"
+	"net"
+	"net/netip"
+	"testing"
+)
+
+func TestMarshalIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "ipv4 string", args: "192.168.0.1", want: "192.168.0.1"},
+		{name: "ipv6 string", args: "2001:db8::1", want: "2001:db8::1"},
+		{name: "inet with netmask", args: "192.168.0.1/24", want: "192.168.0.1"},
+		{name: "ipv4 binary", args: []byte{10, 0, 0, 1}, want: "10.0.0.1"},
+		{name: "ipv6 binary", args: []byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}, want: "2001:db8::1"},
+		{name: "text bytes", args: []byte("10.0.0.1"), want: "10.0.0.1"},
+		{name: "4-byte text", args: []byte("1::2"), want: "1::2"},
+		{name: "4-byte ipv6 text", args: []byte("::ff"), want: "::ff"},
+		{name: "16-byte text", args: []byte("2001:db8:0:1::10"), want: "2001:db8:0:1::10"},
+		{name: "16-byte text with netmask", args: []byte("192.168.100.1/24"), want: "192.168.100.1"},
+		{name: "invalid text bytes", args: []byte("10.0.0.256"), wantErr: true},
+		{name: "net.IP", args: net.ParseIP("10.0.0.1"), want: "10.0.0.1"},
+		{name: "netip.Addr", args: netip.MustParseAddr("::1"), want: "::1"},
+		{name: "invalid string", args: "256.0.0.1", wantErr: true},
+		{name: "zero netip.Addr", args: netip.Addr{}, wantErr: true},
+		{name: "int", args: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalIP(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalIP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.String() != tt.want {
+				t.Errorf("MarshalIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPHelpers(t *testing.T) {
+	v4 := MustIP("10.0.0.1")
+	v6 := MustIP("::1")
+	if !v4.Is4() || v4.Is6() || !v4.IsPrivate() || v4.IsLoopback() {
+		t.Errorf("IP helpers for %v", v4)
+	}
+	if v6.Is4() || !v6.Is6() || v6.IsPrivate() || !v6.IsLoopback() {
+		t.Errorf("IP helpers for %v", v6)
+	}
+	var invalid IP
+	if invalid.Is4() || invalid.Is6() || invalid.IsPrivate() {
+		t.Errorf("IP helpers for invalid IP")
+	}
+}
+
+func TestIPJSONAndText(t *testing.T) {
+	var s struct {
+		IP    IP `json:"ip"`
+		Empty IP `json:"empty"`
+	}
+	if err := json.Unmarshal([]byte(`{"ip":"2001:db8::1","empty":null}`), &s); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	b, _ := json.Marshal(s)
+	if string(b) != `{"ip":"2001:db8::1","empty":null}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+	text, _ := s.IP.MarshalText()
+	var v IP
+	if err := v.UnmarshalText(text); err != nil || !v.Equal(s.IP) {
+		t.Errorf("UnmarshalText() = %v, %v", v, err)
+	}
+	dv, _ := v.Value()
+	if dv != "2001:db8::1" {
+		t.Errorf("IP.Value() = %v", dv)
+	}
+}
@@ -0,0 +1,135 @@
+package generic
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// JSON is generic JSON document type structure.
+//
+// An invalid JSON is SQL NULL or an absent value, while a valid JSON may hold the JSON literal null.
+type JSON struct {
+	ValidFlag
+	raw json.RawMessage
+}
+
+// MarshalJSONDocument return generic.JSON converting of request data
+func MarshalJSONDocument(x interface{}) (JSON, error) {
+	v := JSON{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustJSONDocument return generic.JSON converting of request data
+func MustJSONDocument(x interface{}) JSON {
+	v, err := MarshalJSONDocument(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The document is returned as string, which drivers accept for both json and jsonb columns.
+func (v JSON) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return string(v.raw), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x must be a valid JSON document as []byte, string or json.RawMessage.
+func (v *JSON) Scan(x interface{}) (err error) {
+	v.raw, v.ValidFlag, err = asJSON(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns the document as string, but if JSON.ValidFlag is false, returns nil.
+func (v JSON) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *JSON) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Raw returns json.RawMessage, but if JSON.ValidFlag is false, returns nil.
+// The returned slice must not be modified.
+func (v JSON) Raw() json.RawMessage {
+	if !v.Valid() {
+		return nil
+	}
+	return v.raw
+}
+
+// String implements the Stringer interface.
+func (v JSON) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return string(v.raw)
+}
+
+// IsNull reports whether v holds the JSON literal null.
+// It is false for an invalid JSON, which represents SQL NULL.
+func (v JSON) IsNull() bool {
+	return v.Valid() && bytes.Equal(bytes.TrimSpace(v.raw), nullBytes)
+}
+
+// Decode unmarshals the document into x.
+// It returns ErrInvalidGenericValue if JSON.ValidFlag is false.
+func (v JSON) Decode(x interface{}) error {
+	if !v.Valid() {
+		return ErrInvalidGenericValue{Value: nil}
+	}
+	return json.Unmarshal(v.raw, x)
+}
+
+// Encode marshals x and sets it as the document.
+func (v *JSON) Encode(x interface{}) error {
+	b, err := json.Marshal(x)
+	if err != nil {
+		return err
+	}
+	v.raw, v.ValidFlag = b, true
+	return nil
+}
+
+// Equal reports whether v and x are the same document, ignoring insignificant whitespace.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v JSON) Equal(x JSON) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	a, b := bytes.Buffer{}, bytes.Buffer{}
+	if json.Compact(&a, v.raw) != nil || json.Compact(&b, x.raw) != nil {
+		return bytes.Equal(v.raw, x.raw)
+	}
+	return bytes.Equal(a.Bytes(), b.Bytes())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The document is embedded verbatim.
+func (v JSON) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return v.raw, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// A JSON null is kept as a valid document, so it can be told apart from an absent value.
+func (v *JSON) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return v.Scan(data)
+}
@@ -0,0 +1,125 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalJSONDocument(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      interface{}
+		wantValid bool
+		wantNull  bool
+		wantErr   bool
+	}{
+		{name: "object bytes", args: []byte(`{"a":1}`), wantValid: true},
+		{name: "array string", args: `[1,2]`, wantValid: true},
+		{name: "raw message", args: json.RawMessage(`"x"`), wantValid: true},
+		{name: "json null", args: "null", wantValid: true, wantNull: true},
+		{name: "sql null", args: nil},
+		{name: "nil bytes", args: []byte(nil)},
+		{name: "malformed", args: `{"a":`, wantErr: true},
+		{name: "empty string", args: "", wantErr: true},
+		{name: "int", args: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalJSONDocument(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalJSONDocument() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Valid() != tt.wantValid {
+				t.Errorf("MarshalJSONDocument() valid = %v, want %v", got.Valid(), tt.wantValid)
+			}
+			if got.IsNull() != tt.wantNull {
+				t.Errorf("JSON.IsNull() = %v, want %v", got.IsNull(), tt.wantNull)
+			}
+		})
+	}
+}
+
+func TestJSONScanCopies(t *testing.T) {
+	buf := []byte(`[1]`)
+	var v JSON
+	if err := v.Scan(buf); err != nil {
+		t.Fatalf("JSON.Scan() error = %v", err)
+	}
+	buf[1] = '2'
+	if v.String() != `[1]` {
+		t.Errorf("JSON.Scan() shares memory with the source")
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	v := MustJSONDocument(`{"a":1}`)
+	got, err := v.Value()
+	if err != nil || got != `{"a":1}` {
+		t.Errorf("JSON.Value() = %v, %v, want {\"a\":1}", got, err)
+	}
+	got, err = JSON{}.Value()
+	if err != nil || got != nil {
+		t.Errorf("JSON.Value() = %v, %v, want nil", got, err)
+	}
+}
+
+func TestJSONDecodeEncode(t *testing.T) {
+	type meta struct {
+		Tags []string `json:"tags"`
+	}
+	var v JSON
+	if err := v.Encode(meta{Tags: []string{"a", "b"}}); err != nil {
+		t.Fatalf("JSON.Encode() error = %v", err)
+	}
+	if v.String() != `{"tags":["a","b"]}` {
+		t.Errorf("JSON.Encode() = %s", v.String())
+	}
+	var m meta
+	if err := v.Decode(&m); err != nil {
+		t.Fatalf("JSON.Decode() error = %v", err)
+	}
+	if len(m.Tags) != 2 || m.Tags[1] != "b" {
+		t.Errorf("JSON.Decode() = %v", m)
+	}
+	if err := (JSON{}).Decode(&m); err == nil {
+		t.Error("JSON.Decode() expected error for invalid value")
+	}
+}
+
+func TestJSONEmbed(t *testing.T) {
+	type row struct {
+		Meta  JSON `json:"meta"`
+		Extra JSON `json:"extra"`
+	}
+	var r row
+	if err := json.Unmarshal([]byte(`{"meta":{"a": [1, 2]},"extra":null}`), &r); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !r.Extra.Valid() || !r.Extra.IsNull() {
+		t.Errorf("JSON null should be kept as a valid document")
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(b) != `{"meta":{"a":[1,2]},"extra":null}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+	b, _ = json.Marshal(row{})
+	if string(b) != `{"meta":null,"extra":null}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+}
+
+func TestJSONEqual(t *testing.T) {
+	a := MustJSONDocument(`{"a": 1}`)
+	if !a.Equal(MustJSONDocument(`{"a":1}`)) {
+		t.Error("JSON.Equal() should ignore whitespace")
+	}
+	if a.Equal(MustJSONDocument(`{"a":2}`)) || a.Equal(JSON{}) {
+		t.Error("JSON.Equal() = true, want false")
+	}
+	if !(JSON{}).Equal(JSON{}) {
+		t.Error("JSON.Equal() of invalid values = false, want true")
+	}
+}
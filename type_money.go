@@ -0,0 +1,259 @@
+package generic
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+)
+
+// moneyAmount is an amount in the minor units of an ISO 4217 currency
+type moneyAmount struct {
+	amount   int64
+	currency string
+}
+
+// Money is generic money type structure.
+// The amount is kept as an integer in the minor units of the currency, such as cents for USD.
+type Money struct {
+	ValidFlag
+	money moneyAmount
+}
+
+// NewMoney returns generic.Money of amount in the minor units of currency.
+func NewMoney(amount int64, currency string) (Money, error) {
+	code, err := normalizeCurrency(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{ValidFlag: true, money: moneyAmount{amount: amount, currency: code}}, nil
+}
+
+// MarshalMoney return generic.Money converting of request data
+func MarshalMoney(x interface{}) (Money, error) {
+	v := Money{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustMoney return generic.Money converting of request data
+func MustMoney(x interface{}) Money {
+	v, err := MarshalMoney(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as "12.34 USD".
+func (v Money) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be text such as "12.34 USD", a composite literal such as "(12.34,USD)",
+// or []interface{}{amount, currency}.
+func (v *Money) Scan(x interface{}) (err error) {
+	v.money, v.ValidFlag, err = asMoney(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// ScanColumns sets the value from separate amount and currency columns.
+// The amount is in major units and is rounded to the minor units of the currency.
+// A NULL amount makes the value invalid.
+func (v *Money) ScanColumns(amount, currency interface{}) (err error) {
+	v.money, v.ValidFlag, err = asMoneyColumns(amount, currency)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns the value formatted as "12.34 USD", but if Money.ValidFlag is false, returns nil.
+func (v Money) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *Money) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Amount returns the amount in minor units, but if Money.ValidFlag is false, returns 0.
+func (v Money) Amount() int64 {
+	if !v.Valid() {
+		return 0
+	}
+	return v.money.amount
+}
+
+// Currency returns the ISO 4217 currency code, but if Money.ValidFlag is false, returns an empty string.
+func (v Money) Currency() string {
+	if !v.Valid() {
+		return ""
+	}
+	return v.money.currency
+}
+
+// Decimal returns the amount in major units such as "12.34", but if Money.ValidFlag is false, returns an empty string.
+func (v Money) Decimal() string {
+	if !v.Valid() {
+		return ""
+	}
+	return formatMinorUnits(v.money.amount, currencyMinorUnits[v.money.currency])
+}
+
+// String implements the Stringer interface.
+func (v Money) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return v.Decimal() + " " + v.money.currency
+}
+
+// Add returns v + x.
+// If either operand is invalid, Add returns an invalid Money.
+func (v Money) Add(x Money) (Money, error) {
+	if !v.Valid() || !x.Valid() {
+		return Money{}, nil
+	}
+	if v.money.currency != x.money.currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	r, err := MustInt(v.money.amount).Add(MustInt(x.money.amount))
+	return v.withAmount(r, err)
+}
+
+// Sub returns v - x.
+// If either operand is invalid, Sub returns an invalid Money.
+func (v Money) Sub(x Money) (Money, error) {
+	if !v.Valid() || !x.Valid() {
+		return Money{}, nil
+	}
+	if v.money.currency != x.money.currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	r, err := MustInt(v.money.amount).Sub(MustInt(x.money.amount))
+	return v.withAmount(r, err)
+}
+
+// Mul returns v multiplied by n.
+// If v is invalid, Mul returns an invalid Money.
+func (v Money) Mul(n int64) (Money, error) {
+	if !v.Valid() {
+		return Money{}, nil
+	}
+	r, err := MustInt(v.money.amount).Mul(MustInt(n))
+	return v.withAmount(r, err)
+}
+
+// Neg returns -v.
+// If v is invalid, Neg returns an invalid Money.
+func (v Money) Neg() (Money, error) {
+	if !v.Valid() {
+		return Money{}, nil
+	}
+	r, err := MustInt(v.money.amount).Neg()
+	return v.withAmount(r, err)
+}
+
+// Abs returns the absolute value of v.
+// If v is invalid, Abs returns an invalid Money.
+func (v Money) Abs() (Money, error) {
+	if v.Valid() && v.money.amount < 0 {
+		return v.Neg()
+	}
+	return v, nil
+}
+
+func (v Money) withAmount(r Int, err error) (Money, error) {
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{ValidFlag: true, money: moneyAmount{amount: r.Int64(), currency: v.money.currency}}, nil
+}
+
+// Equal reports whether v and x have the same amount and currency.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Money) Equal(x Money) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.money == x.money
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// Values are ordered by currency code first, then by amount.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Money) Compare(x Money, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	if c := strings.Compare(v.money.currency, x.money.currency); c != 0 {
+		return c
+	}
+	switch {
+	case v.money.amount < x.money.amount:
+		return -1
+	case v.money.amount > x.money.amount:
+		return 1
+	}
+	return 0
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The value is encoded as {"amount":"12.34","currency":"USD"}.
+func (v Money) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{v.Decimal(), v.money.currency})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts {"amount":"12.34","currency":"USD"}, where the amount may also be a number, and "12.34 USD".
+func (v *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil
+	}
+	if data[0] != '{' {
+		var in interface{}
+		if err := json.Unmarshal(data, &in); err != nil {
+			return err
+		}
+		return v.Scan(in)
+	}
+	var in struct {
+		Amount   json.RawMessage `json:"amount"`
+		Currency interface{}     `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	var amount interface{}
+	if len(in.Amount) > 0 && in.Amount[0] == '"' {
+		var s string
+		if err := json.Unmarshal(in.Amount, &s); err != nil {
+			return err
+		}
+		amount = s
+	} else if len(in.Amount) > 0 && !bytes.Equal(in.Amount, nullBytes) {
+		// keep the literal, so that the amount is not rounded through float64
+		amount = string(in.Amount)
+	}
+	return v.ScanColumns(amount, in.Currency)
+}
@@ -0,0 +1,148 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMarshalMoney(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      interface{}
+		want      string
+		wantValid bool
+		wantErr   error
+	}{
+		{name: "text", args: "12.34 USD", want: "12.34 USD", wantValid: true},
+		{name: "lower case code", args: []byte("5 eur"), want: "5.00 EUR", wantValid: true},
+		{name: "composite", args: `(12.3,"JPY")`, want: "12 JPY", wantValid: true},
+		{name: "composite null amount", args: "(,USD)"},
+		{name: "round half away from zero", args: "-0.125 USD", want: "-0.13 USD", wantValid: true},
+		{name: "three digits", args: "1.2345 KWD", want: "1.235 KWD", wantValid: true},
+		{name: "columns", args: []interface{}{1.1, "USD"}, want: "1.10 USD", wantValid: true},
+		{name: "int column", args: []interface{}{int64(3), []byte("JPY")}, want: "3 JPY", wantValid: true},
+		{name: "null amount column", args: []interface{}{nil, "USD"}},
+		{name: "nil", args: nil},
+		{name: "unknown currency", args: "1 ABC", wantErr: ErrUnknownCurrency},
+		{name: "gold has no minor units", args: "1 XAU", wantErr: ErrUnknownCurrency},
+		{name: "overflow", args: "92233720368547758.08 USD", wantErr: ErrOverflow},
+		{name: "bad amount", args: "1.2.3 USD", wantErr: ErrInvalidGenericValue{Value: "1.2.3"}},
+		{name: "missing currency", args: "12.34", wantErr: ErrInvalidGenericValue{Value: "12.34"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalMoney(tt.args)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MarshalMoney() error = %v, want %v", err, tt.wantErr)
+			}
+			if got.Valid() != tt.wantValid {
+				t.Errorf("MarshalMoney() valid = %v, want %v", got.Valid(), tt.wantValid)
+			}
+			if got.String() != tt.want {
+				t.Errorf("MarshalMoney() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyMinorUnits(t *testing.T) {
+	v := MustMoney("-92233720368547758.08 USD")
+	if v.Amount() != -1<<63 {
+		t.Errorf("Money.Amount() = %d, want MinInt64", v.Amount())
+	}
+	if v.Decimal() != "-92233720368547758.08" {
+		t.Errorf("Money.Decimal() = %s", v.Decimal())
+	}
+	v, err := NewMoney(5, "usd")
+	if err != nil || v.Decimal() != "0.05" || v.Currency() != "USD" {
+		t.Errorf("NewMoney() = %v, %v", v, err)
+	}
+	if _, err := NewMoney(5, "US"); err != ErrUnknownCurrency {
+		t.Errorf("NewMoney() error = %v, want ErrUnknownCurrency", err)
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{data: `{"amount":"12.34","currency":"JPY"}`, want: `{"amount":"12","currency":"JPY"}`},
+		{data: `{"amount":0.1,"currency":"USD"}`, want: `{"amount":"0.10","currency":"USD"}`},
+		{data: `"12.34 USD"`, want: `{"amount":"12.34","currency":"USD"}`},
+		{data: `{"amount":null,"currency":"USD"}`, want: `null`},
+		{data: `null`, want: `null`},
+		{data: `{"amount":"1","currency":"ZZZ"}`, wantErr: true},
+		{data: `{"amount":"1"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var v Money
+			err := json.Unmarshal([]byte(tt.data), &v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Money.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			b, err := json.Marshal(v)
+			if err != nil || string(b) != tt.want {
+				t.Errorf("Money.MarshalJSON() = %s, %v, want %s", b, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	a := MustMoney("10.50 USD")
+	b := MustMoney("0.75 USD")
+	if r, err := a.Add(b); err != nil || r.String() != "11.25 USD" {
+		t.Errorf("Money.Add() = %v, %v", r, err)
+	}
+	if r, err := b.Sub(a); err != nil || r.String() != "-9.75 USD" {
+		t.Errorf("Money.Sub() = %v, %v", r, err)
+	}
+	if r, err := b.Mul(3); err != nil || r.String() != "2.25 USD" {
+		t.Errorf("Money.Mul() = %v, %v", r, err)
+	}
+	if r, err := b.Neg(); err != nil || r.Amount() != -75 {
+		t.Errorf("Money.Neg() = %v, %v", r, err)
+	}
+	if _, err := a.Add(MustMoney("1 EUR")); err != ErrCurrencyMismatch {
+		t.Errorf("Money.Add() error = %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := MustMoney("92233720368547758.07 USD").Add(MustMoney("0.01 USD")); err != ErrOverflow {
+		t.Errorf("Money.Add() error = %v, want ErrOverflow", err)
+	}
+	if r, err := a.Add(Money{}); err != nil || r.Valid() {
+		t.Errorf("Money.Add() with invalid operand = %v, %v", r, err)
+	}
+}
+
+func TestMoneyCompare(t *testing.T) {
+	if MustMoney("1 USD").Compare(MustMoney("2 USD"), NullsFirst) != -1 {
+		t.Error("Money.Compare() should order by amount")
+	}
+	if MustMoney("9 EUR").Compare(MustMoney("1 USD"), NullsFirst) != -1 {
+		t.Error("Money.Compare() should order by currency first")
+	}
+	if !MustMoney("1 USD").Equal(MustMoney("1.00 usd")) || MustMoney("1 USD").Equal(MustMoney("1 EUR")) {
+		t.Error("Money.Equal() is wrong")
+	}
+}
+
+func TestMoneyValue(t *testing.T) {
+	got, err := MustMoney("(7,KWD)").Value()
+	if err != nil || got != "7.000 KWD" {
+		t.Errorf("Money.Value() = %v, %v", got, err)
+	}
+	var v Money
+	if err := v.ScanColumns([]byte("1.5"), MustString("CLF")); err != nil || v.String() != "1.5000 CLF" {
+		t.Errorf("Money.ScanColumns() = %v, %v", v, err)
+	}
+	if err := v.ScanColumns("1", nil); err == nil || v.Valid() {
+		t.Errorf("Money.ScanColumns() expected error for NULL currency")
+	}
+}
@@ -0,0 +1,193 @@
+package generic
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/netip"
+)
+
+// Prefix is generic netip.Prefix type structure
+type Prefix struct {
+	ValidFlag
+	prefix netip.Prefix
+}
+
+// MarshalPrefix return generic.Prefix converting of request data
+func MarshalPrefix(x interface{}) (Prefix, error) {
+	v := Prefix{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustPrefix return generic.Prefix converting of request data
+func MustPrefix(x interface{}) Prefix {
+	v, err := MarshalPrefix(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is a string accepted by PostgreSQL inet columns; use Masked for cidr columns, which reject host bits.
+func (v Prefix) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.prefix.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a string in CIDR notation, *net.IPNet or netip.Prefix. An address without a netmask is a single host prefix.
+func (v *Prefix) Scan(x interface{}) (err error) {
+	v.prefix, v.ValidFlag, err = asPrefix(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns netip.Prefix, but if Prefix.ValidFlag is false, returns nil.
+func (v Prefix) Weak() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	return v.prefix
+}
+
+// Set sets a specified value.
+func (v *Prefix) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Prefix returns netip.Prefix, but if Prefix.ValidFlag is false, returns the zero netip.Prefix.
+func (v Prefix) Prefix() netip.Prefix {
+	if !v.Valid() {
+		return netip.Prefix{}
+	}
+	return v.prefix
+}
+
+// Addr returns the address of the prefix.
+func (v Prefix) Addr() IP {
+	if !v.Valid() {
+		return IP{}
+	}
+	return IP{ValidFlag: true, ip: v.prefix.Addr()}
+}
+
+// Bits returns the prefix length, or -1 if Prefix.ValidFlag is false.
+func (v Prefix) Bits() int {
+	if !v.Valid() {
+		return -1
+	}
+	return v.prefix.Bits()
+}
+
+// Masked returns v with the host bits zeroed.
+func (v Prefix) Masked() Prefix {
+	if !v.Valid() {
+		return Prefix{}
+	}
+	return Prefix{ValidFlag: true, prefix: v.prefix.Masked()}
+}
+
+// String implements the Stringer interface.
+func (v Prefix) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return v.prefix.String()
+}
+
+// Contains reports whether the network of v includes ip.
+func (v Prefix) Contains(ip IP) bool {
+	return v.Valid() && ip.Valid() && v.prefix.Contains(ip.ip)
+}
+
+// Overlaps reports whether v and x contain any address in common.
+func (v Prefix) Overlaps(x Prefix) bool {
+	return v.Valid() && x.Valid() && v.prefix.Overlaps(x.prefix)
+}
+
+// Is4 reports whether v is an IPv4 prefix.
+func (v Prefix) Is4() bool {
+	return v.Valid() && v.prefix.Addr().Is4()
+}
+
+// Is6 reports whether v is an IPv6 prefix.
+func (v Prefix) Is6() bool {
+	return v.Valid() && v.prefix.Addr().Is6()
+}
+
+// IsPrivate reports whether the address of v is a private address.
+func (v Prefix) IsPrivate() bool {
+	return v.Valid() && v.prefix.Addr().IsPrivate()
+}
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Prefix) Equal(x Prefix) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.prefix == x.prefix
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// Prefixes are ordered by address first and then by length.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Prefix) Compare(x Prefix, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	if c := v.prefix.Addr().Compare(x.prefix.Addr()); c != 0 {
+		return c
+	}
+	switch {
+	case v.prefix.Bits() < x.prefix.Bits():
+		return -1
+	case v.prefix.Bits() > x.prefix.Bits():
+		return 1
+	}
+	return 0
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Prefix) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return []byte{}, nil
+	}
+	return v.prefix.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// An empty text resets v.
+func (v *Prefix) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		v.Reset()
+		return nil
+	}
+	return v.Scan(string(text))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Prefix) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return json.Marshal(v.prefix.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *Prefix) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var in interface{}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	return v.Scan(in)
+}
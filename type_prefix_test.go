@@ -0,0 +1,72 @@
+package generic
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+)
+
+func TestMarshalPrefix(t *testing.T) {
+	_, ipnet, _ := net.ParseCIDR("10.1.0.0/16")
+	tests := []struct {
+		name    string
+		args    interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "cidr", args: "192.168.0.0/24", want: "192.168.0.0/24"},
+		{name: "inet host", args: "192.168.0.1", want: "192.168.0.1/32"},
+		{name: "ipv6", args: []byte("2001:db8::/32"), want: "2001:db8::/32"},
+		{name: "net.IPNet", args: ipnet, want: "10.1.0.0/16"},
+		{name: "v4-mapped net.IPNet with ipv6 mask", args: &net.IPNet{IP: net.ParseIP("10.0.0.0"), Mask: net.CIDRMask(104, 128)}, want: "10.0.0.0/8"},
+		{name: "v4-mapped net.IPNet with short ipv6 mask", args: &net.IPNet{IP: net.ParseIP("10.0.0.0"), Mask: net.CIDRMask(8, 128)}, want: "::ffff:10.0.0.0/8"},
+		{name: "ipv4 net.IPNet with short ipv6 mask", args: &net.IPNet{IP: net.IPv4(10, 0, 0, 0).To4(), Mask: net.CIDRMask(8, 128)}, wantErr: true},
+		{name: "invalid", args: "192.168.0.0/33", wantErr: true},
+		{name: "int", args: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalPrefix(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalPrefix() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.String() != tt.want {
+				t.Errorf("MarshalPrefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefixContains(t *testing.T) {
+	p := MustPrefix("192.168.0.0/16")
+	if !p.Contains(MustIP("192.168.10.1")) {
+		t.Error("Prefix.Contains() = false, want true")
+	}
+	if p.Contains(MustIP("10.0.0.1")) || p.Contains(IP{}) || (Prefix{}).Contains(MustIP("192.168.10.1")) {
+		t.Error("Prefix.Contains() = true, want false")
+	}
+	if !p.Overlaps(MustPrefix("192.168.1.0/24")) || p.Overlaps(MustPrefix("10.0.0.0/8")) {
+		t.Error("Prefix.Overlaps() mismatch")
+	}
+	if !p.Is4() || p.Is6() || !p.IsPrivate() {
+		t.Error("Prefix helpers mismatch")
+	}
+	if m := MustPrefix("192.168.1.5/24").Masked(); m.String() != "192.168.1.0/24" {
+		t.Errorf("Prefix.Masked() = %v", m)
+	}
+}
+
+func TestPrefixJSON(t *testing.T) {
+	var v Prefix
+	if err := json.Unmarshal([]byte(`"10.0.0.0/8"`), &v); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	b, _ := json.Marshal(v)
+	if string(b) != `"10.0.0.0/8"` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+	b, _ = json.Marshal(Prefix{})
+	if string(b) != "null" {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+}
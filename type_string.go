@@ -1,8 +1,10 @@
 package generic
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"strings"
 )
 
 // String is generic string type structure
@@ -85,3 +87,70 @@ func (v *String) UnmarshalJSON(data []byte) error {
 	}
 	return v.Scan(in)
 }
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v String) Equal(x String) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.string == x.string
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v String) Compare(x String, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	return strings.Compare(v.string, x.string)
+}
+
+// Or returns the string value, but if String.ValidFlag is false, returns d.
+func (v String) Or(d string) string {
+	if !v.Valid() {
+		return d
+	}
+	return v.string
+}
+
+// OrElse returns the string value, but if String.ValidFlag is false, returns the result of f.
+func (v String) OrElse(f func() string) string {
+	if !v.Valid() {
+		return f()
+	}
+	return v.string
+}
+
+// Ptr returns a pointer to a copy of the string value, but if String.ValidFlag is false, returns nil.
+func (v String) Ptr() *string {
+	if !v.Valid() {
+		return nil
+	}
+	x := v.string
+	return &x
+}
+
+// StringFromPtr returns generic.String holding *p, or an invalid String if p is nil.
+func StringFromPtr(p *string) String {
+	if p == nil {
+		return String{}
+	}
+	return String{ValidFlag: true, string: *p}
+}
+
+// NullString converts v to sql.NullString.
+func (v String) NullString() sql.NullString {
+	if !v.Valid() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: v.string, Valid: true}
+}
+
+// FromNullString returns generic.String converting of sql.NullString
+func FromNullString(x sql.NullString) String {
+	if !x.Valid {
+		return String{}
+	}
+	return String{ValidFlag: true, string: x.String}
+}
@@ -1,6 +1,7 @@
 package generic
 
 import (
+	"database/sql"
 	"encoding/json"
 	"reflect"
 	"testing"
@@ -205,3 +206,15 @@ func TestStringInvalid(t *testing.T) {
 		t.Errorf("actual:%s, expected: (empty)", ts.String())
 	}
 }
+
+func TestStringNullString(t *testing.T) {
+	if got := MustString("a").NullString(); got != (sql.NullString{String: "a", Valid: true}) {
+		t.Errorf("String.NullString() = %v", got)
+	}
+	if got := FromNullString(sql.NullString{}); got.Valid() {
+		t.Errorf("FromNullString() = %v, want invalid", got)
+	}
+	if got := FromNullString(sql.NullString{String: "a", Valid: true}); got != MustString("a") {
+		t.Errorf("FromNullString() = %v", got)
+	}
+}
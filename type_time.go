@@ -1,7 +1,9 @@
 package generic
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"time"
 )
 
@@ -36,6 +38,7 @@ func (v Time) Value() (driver.Value, error) {
 }
 
 // Scan implements the sql.Scanner interface.
+// Strings and []byte are parsed with DefaultTimeParseOptions.
 func (v *Time) Scan(x interface{}) (err error) {
 	v.time, v.ValidFlag, err = asTime(x)
 	if err != nil {
@@ -86,8 +89,108 @@ func (v *Time) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 	if err := v.time.UnmarshalJSON(data); err != nil {
-		return err
+		// fall back to the layouts of DefaultTimeParseOptions
+		var s string
+		if json.Unmarshal(data, &s) != nil {
+			return err
+		}
+		return v.Scan(s)
 	}
 	v.ValidFlag = true
 	return nil
 }
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Time) Equal(x Time) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.time.Equal(x.time)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Time) Compare(x Time, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.time.Before(x.time):
+		return -1
+	case v.time.After(x.time):
+		return 1
+	}
+	return 0
+}
+
+// Since returns the time elapsed since v by DefaultClock, or 0 if v is invalid.
+func (v Time) Since() time.Duration {
+	return sinceClock(v.time, v.ValidFlag)
+}
+
+// IsPast reports whether v is valid and before the current time of DefaultClock.
+func (v Time) IsPast() bool {
+	return isPast(v.time, v.ValidFlag)
+}
+
+// IsFuture reports whether v is valid and after the current time of DefaultClock.
+func (v Time) IsFuture() bool {
+	return isFuture(v.time, v.ValidFlag)
+}
+
+// ValidateWithin returns ErrTimeOutOfRange unless v is at most past before and at most future after
+// the current time of DefaultClock. An invalid value is rejected with ErrInvalidGenericValue,
+// so a NULL never passes a check such as "not older than 24 hours".
+func (v Time) ValidateWithin(past, future time.Duration) error {
+	return validateWithin(v.time, v.ValidFlag, past, future)
+}
+
+// Or returns the time.Time value, but if Time.ValidFlag is false, returns d.
+func (v Time) Or(d time.Time) time.Time {
+	if !v.Valid() {
+		return d
+	}
+	return v.time
+}
+
+// OrElse returns the time.Time value, but if Time.ValidFlag is false, returns the result of f.
+func (v Time) OrElse(f func() time.Time) time.Time {
+	if !v.Valid() {
+		return f()
+	}
+	return v.time
+}
+
+// Ptr returns a pointer to a copy of the time.Time value, but if Time.ValidFlag is false, returns nil.
+func (v Time) Ptr() *time.Time {
+	if !v.Valid() {
+		return nil
+	}
+	x := v.time
+	return &x
+}
+
+// TimeFromPtr returns generic.Time holding *p, or an invalid Time if p is nil.
+func TimeFromPtr(p *time.Time) Time {
+	if p == nil {
+		return Time{}
+	}
+	return Time{ValidFlag: true, time: *p}
+}
+
+// NullTime converts v to sql.NullTime.
+func (v Time) NullTime() sql.NullTime {
+	if !v.Valid() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: v.time, Valid: true}
+}
+
+// FromNullTime returns generic.Time converting of sql.NullTime
+func FromNullTime(x sql.NullTime) Time {
+	if !x.Valid {
+		return Time{}
+	}
+	return Time{ValidFlag: true, time: x.Time}
+}
@@ -0,0 +1,241 @@
+package generic
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// TimeRange is generic time range type structure.
+// Each side is inclusive or exclusive, and a side whose bound is invalid is unbounded.
+// It is stored in PostgreSQL tstzrange columns.
+type TimeRange struct {
+	ValidFlag
+	r rangeOf[time.Time]
+}
+
+// NewTimeRange returns generic.TimeRange from start to end.
+// bounds is one of "[]", "[)", "(]" and "()", where a square bracket makes the side inclusive.
+// An invalid start or end makes the side unbounded.
+func NewTimeRange(start, end Time, bounds string) (TimeRange, error) {
+	r, err := newRange(start.time, start.Valid(), end.time, end.Valid(), bounds, compareTime)
+	if err != nil {
+		return TimeRange{}, err
+	}
+	return TimeRange{ValidFlag: true, r: r}, nil
+}
+
+// MarshalTimeRange return generic.TimeRange converting of request data
+func MarshalTimeRange(x interface{}) (TimeRange, error) {
+	v := TimeRange{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustTimeRange return generic.TimeRange converting of request data
+func MustTimeRange(x interface{}) TimeRange {
+	v, err := MarshalTimeRange(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// The value is formatted as a PostgreSQL range literal such as `[2024-01-02T00:00:00Z,2024-01-03T00:00:00Z)`.
+func (v TimeRange) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be a PostgreSQL tstzrange or tsrange literal as string or []byte.
+func (v *TimeRange) Scan(x interface{}) (err error) {
+	v.r, v.ValidFlag, err = asTimeRange(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns the range literal, but if TimeRange.ValidFlag is false, returns nil.
+func (v TimeRange) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *TimeRange) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Start returns the lower bound, or an invalid Time if the range is unbounded below, empty or invalid.
+func (v TimeRange) Start() Time {
+	if !v.Valid() || !v.r.hasLower {
+		return Time{}
+	}
+	return Time{ValidFlag: true, time: v.r.lower}
+}
+
+// End returns the upper bound, or an invalid Time if the range is unbounded above, empty or invalid.
+func (v TimeRange) End() Time {
+	if !v.Valid() || !v.r.hasUpper {
+		return Time{}
+	}
+	return Time{ValidFlag: true, time: v.r.upper}
+}
+
+// Bounds returns the inclusiveness of the sides such as "[)".
+// An unbounded side is always exclusive.
+func (v TimeRange) Bounds() string {
+	return v.r.bounds()
+}
+
+// IsEmpty reports whether the range contains no time
+func (v TimeRange) IsEmpty() bool {
+	return v.Valid() && v.r.empty
+}
+
+// Contains reports whether t is in the range.
+func (v TimeRange) Contains(t time.Time) bool {
+	return v.Valid() && v.r.contains(t, compareTime)
+}
+
+// Overlaps reports whether v and x have any time in common.
+func (v TimeRange) Overlaps(x TimeRange) bool {
+	return v.Valid() && x.Valid() && v.r.overlaps(x.r, compareTime)
+}
+
+// Intersect returns the range of time in both v and x, which is empty if they do not overlap.
+// If either range is invalid, Intersect returns an invalid TimeRange.
+func (v TimeRange) Intersect(x TimeRange) TimeRange {
+	if !v.Valid() || !x.Valid() {
+		return TimeRange{}
+	}
+	return TimeRange{ValidFlag: true, r: v.r.intersect(x.r, compareTime)}
+}
+
+// Union returns the range of time in either v or x.
+// It returns ErrRangeNotContiguous if v and x neither overlap nor touch.
+// If either range is invalid, Union returns an invalid TimeRange.
+func (v TimeRange) Union(x TimeRange) (TimeRange, error) {
+	if !v.Valid() || !x.Valid() {
+		return TimeRange{}, nil
+	}
+	r, err := v.r.union(x.r, compareTime)
+	if err != nil {
+		return TimeRange{}, err
+	}
+	return TimeRange{ValidFlag: true, r: r}, nil
+}
+
+// Duration returns the length of the range, and false if the range is unbounded or invalid.
+func (v TimeRange) Duration() (time.Duration, bool) {
+	switch {
+	case !v.Valid():
+		return 0, false
+	case v.r.empty:
+		return 0, true
+	case !v.r.hasLower || !v.r.hasUpper:
+		return 0, false
+	}
+	return v.r.upper.Sub(v.r.lower), true
+}
+
+// Equal reports whether v and x are the same range.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v TimeRange) Equal(x TimeRange) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.r.equal(x.r, compareTime)
+}
+
+// String implements the Stringer interface.
+// It returns the PostgreSQL range literal, or an empty string if TimeRange.ValidFlag is false.
+func (v TimeRange) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return formatRangeLiteral(rangeLiteral{
+		lower:    v.r.lower.Format(time.RFC3339Nano),
+		upper:    v.r.upper.Format(time.RFC3339Nano),
+		hasLower: v.r.hasLower,
+		hasUpper: v.r.hasUpper,
+		bounds:   v.r.bounds(),
+		empty:    v.r.empty,
+	})
+}
+
+// timeRangeJSON is the JSON form of TimeRange.
+// Bounds is omitted for the default "[)", and Empty is set only for empty ranges.
+type timeRangeJSON struct {
+	Start  Time   `json:"start"`
+	End    Time   `json:"end"`
+	Bounds string `json:"bounds,omitempty"`
+	Empty  bool   `json:"empty,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The value is encoded as {"start":...,"end":...}, where null is unbounded.
+func (v TimeRange) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	if v.r.empty {
+		return json.Marshal(timeRangeJSON{Empty: true})
+	}
+	j := timeRangeJSON{Start: v.Start(), End: v.End()}
+	if b := v.r.bounds(); b != "[)" {
+		j.Bounds = b
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts {"start":...,"end":...} with optional "bounds", which defaults to "[)", and range literal strings.
+func (v *TimeRange) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil
+	}
+	if data[0] != '{' {
+		var in interface{}
+		if err := json.Unmarshal(data, &in); err != nil {
+			return err
+		}
+		return v.Scan(in)
+	}
+	var in struct {
+		Start  interface{} `json:"start"`
+		End    interface{} `json:"end"`
+		Bounds string      `json:"bounds"`
+		Empty  bool        `json:"empty"`
+	}
+	if err := json.Unmarshal(data, &in); err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	if in.Empty {
+		*v = TimeRange{ValidFlag: true, r: rangeOf[time.Time]{empty: true}}
+		return nil
+	}
+	if in.Bounds == "" {
+		in.Bounds = "[)"
+	}
+	start, err := MarshalTime(in.Start)
+	if err == nil {
+		var end Time
+		if end, err = MarshalTime(in.End); err == nil {
+			*v, err = NewTimeRange(start, end, in.Bounds)
+		}
+	}
+	if err != nil {
+		v.ValidFlag = false
+	}
+	return err
+}
@@ -0,0 +1,131 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func day(d int) time.Time {
+	return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+}
+
+func mustTimeRange(t *testing.T, start, end Time, bounds string) TimeRange {
+	t.Helper()
+	r, err := NewTimeRange(start, end, bounds)
+	if err != nil {
+		t.Fatalf("NewTimeRange() error = %v", err)
+	}
+	return r
+}
+
+func TestTimeRangeScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "tstzrange", args: `["2024-01-01 00:00:00+00","2024-01-02 09:00:00+09")`, want: `[2024-01-01T00:00:00Z,2024-01-02T09:00:00+09:00)`},
+		{name: "bytes", args: []byte(`[2024-01-01T00:00:00Z,2024-01-02T00:00:00Z]`), want: `[2024-01-01T00:00:00Z,2024-01-02T00:00:00Z]`},
+		{name: "unbounded", args: `(,"2024-01-02 00:00:00+00")`, want: `(,2024-01-02T00:00:00Z)`},
+		{name: "infinity", args: `["2024-01-01 00:00:00+00",infinity)`, want: `[2024-01-01T00:00:00Z,)`},
+		{name: "empty", args: "empty", want: "empty"},
+		{name: "nil", args: nil},
+		{name: "reversed", args: `[2024-01-02,2024-01-01)`, wantErr: true},
+		{name: "bad time", args: `[x,2024-01-01)`, wantErr: true},
+		{name: "int", args: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalTimeRange(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalTimeRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.String() != tt.want {
+				t.Errorf("TimeRange.String() = %s, want %s", got.String(), tt.want)
+			}
+			if v, _ := got.Value(); tt.want != "" && v != tt.want {
+				t.Errorf("TimeRange.Value() = %v, want %s", v, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeRangeOperations(t *testing.T) {
+	a := mustTimeRange(t, MustTime(day(1)), MustTime(day(3)), "[)")
+	b := mustTimeRange(t, MustTime(day(3)), MustTime(day(5)), "[)")
+	c := mustTimeRange(t, MustTime(day(2)), Time{}, "[)")
+
+	if !a.Contains(day(1)) || a.Contains(day(3)) || !c.Contains(day(30)) {
+		t.Error("TimeRange.Contains() is wrong")
+	}
+	if a.Overlaps(b) || !a.Overlaps(c) || !b.Overlaps(c) {
+		t.Error("TimeRange.Overlaps() is wrong")
+	}
+	if got := a.Intersect(c); got.String() != `[2024-01-02T00:00:00Z,2024-01-03T00:00:00Z)` {
+		t.Errorf("TimeRange.Intersect() = %s", got)
+	}
+	if got := a.Intersect(b); !got.IsEmpty() {
+		t.Errorf("TimeRange.Intersect() = %s, want empty", got)
+	}
+	u, err := a.Union(b)
+	if err != nil || u.String() != `[2024-01-01T00:00:00Z,2024-01-05T00:00:00Z)` {
+		t.Errorf("TimeRange.Union() = %s, %v", u, err)
+	}
+	if u, err = a.Union(c); err != nil || u.End().Valid() || !u.Start().Time().Equal(day(1)) {
+		t.Errorf("TimeRange.Union() = %s, %v", u, err)
+	}
+	d := mustTimeRange(t, MustTime(day(4)), MustTime(day(5)), "[)")
+	if _, err = a.Union(d); err != ErrRangeNotContiguous {
+		t.Errorf("TimeRange.Union() error = %v, want ErrRangeNotContiguous", err)
+	}
+	if got, ok := a.Duration(); !ok || got != 48*time.Hour {
+		t.Errorf("TimeRange.Duration() = %v, %v", got, ok)
+	}
+	if _, ok := c.Duration(); ok {
+		t.Error("TimeRange.Duration() of unbounded range should not be ok")
+	}
+	if !a.Equal(MustTimeRange(`[2024-01-01T00:00:00Z,2024-01-03T00:00:00Z)`)) || a.Equal(b) {
+		t.Error("TimeRange.Equal() is wrong")
+	}
+	if a.Intersect(TimeRange{}).Valid() || a.Overlaps(TimeRange{}) {
+		t.Error("operations with an invalid range should be invalid")
+	}
+}
+
+func TestTimeRangeJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{data: `{"start":"2024-01-01T00:00:00Z","end":"2024-01-02T00:00:00Z"}`, want: `{"start":"2024-01-01T00:00:00Z","end":"2024-01-02T00:00:00Z"}`},
+		{data: `{"start":"2024-01-01 00:00:00","end":null,"bounds":"[]"}`, want: `{"start":"2024-01-01T00:00:00Z","end":null}`},
+		{data: `{"start":null,"end":"2024-01-02T00:00:00Z","bounds":"(]"}`, want: `{"start":null,"end":"2024-01-02T00:00:00Z","bounds":"(]"}`},
+		{data: `{"empty":true}`, want: `{"start":null,"end":null,"empty":true}`},
+		{data: `"[2024-01-01T00:00:00Z,2024-01-02T00:00:00Z)"`, want: `{"start":"2024-01-01T00:00:00Z","end":"2024-01-02T00:00:00Z"}`},
+		{data: `null`, want: `null`},
+		{data: `{"start":"2024-01-02T00:00:00Z","end":"2024-01-01T00:00:00Z"}`, wantErr: true},
+		{data: `{"start":"2024-01-01T00:00:00Z","end":null,"bounds":"<>"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var v TimeRange
+			err := json.Unmarshal([]byte(tt.data), &v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TimeRange.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if v.Valid() {
+					t.Error("TimeRange.UnmarshalJSON() should leave an invalid value on error")
+				}
+				return
+			}
+			b, err := json.Marshal(v)
+			if err != nil || string(b) != tt.want {
+				t.Errorf("TimeRange.MarshalJSON() = %s, %v, want %s", b, err, tt.want)
+			}
+		})
+	}
+}
@@ -219,3 +219,104 @@ func TestTimeStringInvalid(t *testing.T) {
 		t.Errorf("expected empty string, actual:%s", tt.String())
 	}
 }
+
+func TestTimeEqual(t *testing.T) {
+	utc := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	jst := utc.In(time.FixedZone("JST", 9*60*60))
+	tests := []struct {
+		name string
+		v    Time
+		x    Time
+		want bool
+	}{
+		{name: "same instant in different locations", v: MustTime(utc), x: MustTime(jst), want: true},
+		{name: "different instants", v: MustTime(utc), x: MustTime(utc.Add(time.Second)), want: false},
+		{name: "valid and invalid", v: MustTime(utc), x: Time{}, want: false},
+		{name: "both invalid", v: Time{}, x: Time{time: utc}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Equal(tt.x); got != tt.want {
+				t.Errorf("Time.Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeCompare(t *testing.T) {
+	v := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := MustTime(v).Compare(MustTime(v.Add(time.Second)), NullsFirst); got != -1 {
+		t.Errorf("Time.Compare() = %v, want -1", got)
+	}
+	if got := MustTime(v).Compare(Time{}, NullsFirst); got != 1 {
+		t.Errorf("Time.Compare() = %v, want 1", got)
+	}
+	if got := MustTime(v).Compare(Time{}, NullsLast); got != -1 {
+		t.Errorf("Time.Compare() = %v, want -1", got)
+	}
+}
+
+func TestTimePtr(t *testing.T) {
+	v := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := MustTime(v).Ptr()
+	if p == nil || !p.Equal(v) {
+		t.Fatalf("Time.Ptr() = %v, want pointer to %v", p, v)
+	}
+	if got := TimeFromPtr(p); !got.Equal(MustTime(v)) {
+		t.Errorf("TimeFromPtr() = %v, want %v", got, v)
+	}
+	if got := (Time{}).Or(v); !got.Equal(v) {
+		t.Errorf("Time.Or() = %v, want %v", got, v)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	o := TimeParseOptions{Layouts: []string{"2006/01/02 15:04"}, Location: jst}
+	got, err := ParseTime("2024/01/02 03:04", o)
+	if err != nil {
+		t.Fatalf("ParseTime() error = %v", err)
+	}
+	if want := time.Date(2024, 1, 2, 3, 4, 0, 0, jst); !got.Time().Equal(want) || got.Time().Location() != jst {
+		t.Errorf("ParseTime() = %v, want %v", got, want)
+	}
+	if _, err = ParseTime("2024-01-02", o); err == nil {
+		t.Error("ParseTime() expected error for unregistered layout")
+	}
+}
+
+func TestRegisterTimeLayout(t *testing.T) {
+	saved := DefaultTimeParseOptions
+	defer func() { DefaultTimeParseOptions = saved }()
+	DefaultTimeParseOptions.Layouts = append([]string(nil), saved.Layouts...)
+
+	if _, err := MarshalTime("02 Jan 2024"); err == nil {
+		t.Fatal("MarshalTime() expected error before registration")
+	}
+	RegisterTimeLayout("02 Jan 2006")
+	got, err := MarshalTime("02 Jan 2024")
+	if err != nil {
+		t.Fatalf("MarshalTime() error = %v", err)
+	}
+	want := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if !got.Time().Equal(want) {
+		t.Errorf("MarshalTime() = %v, want %v", got, want)
+	}
+	a, err := MarshalTimeArray(`{"02 Jan 2024"}`)
+	if err != nil {
+		t.Fatalf("MarshalTimeArray() error = %v", err)
+	}
+	if e := a.Elements(); len(e) != 1 || !e[0].Time().Equal(want) {
+		t.Errorf("MarshalTimeArray() = %v, want [%v]", e, want)
+	}
+}
+
+func TestTimeJsonUnmarshalLayout(t *testing.T) {
+	var v Time
+	if err := json.Unmarshal([]byte(`"2024-01-02 03:04:05"`), &v); err != nil {
+		t.Fatalf("Time.UnmarshalJSON() error = %v", err)
+	}
+	if want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC); !v.Time().Equal(want) {
+		t.Errorf("Time.UnmarshalJSON() = %v, want %v", v, want)
+	}
+}
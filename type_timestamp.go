@@ -1,12 +1,26 @@
 package generic
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"strconv"
 	"time"
 )
 
+// TimestampOptions configures how the timestamp types convert values
+type TimestampOptions struct {
+	// RejectNegative rejects numeric epochs before 1970-01-01T00:00:00Z, as earlier versions did.
+	RejectNegative bool
+
+	// Layouts are tried in order for strings that are not numeric epochs.
+	// Inputs without a zone are read as UTC. If empty, time.RFC3339Nano is used.
+	Layouts []string
+}
+
+// DefaultTimestampOptions is used by Timestamp, TimestampMS, TimestampMicro and TimestampNano
+var DefaultTimestampOptions = TimestampOptions{}
+
 // Timestamp is a wrapped time type structure
 type Timestamp struct {
 	ValidFlag
@@ -59,7 +73,11 @@ func (v *Timestamp) Set(x interface{}) (err error) {
 }
 
 // String implements the Stringer interface.
+// It returns an empty string if Timestamp.ValidFlag is false.
 func (v Timestamp) String() string {
+	if !v.Valid() {
+		return ""
+	}
 	return strconv.FormatInt(v.Int64(), 10)
 }
 
@@ -70,12 +88,18 @@ func (v Timestamp) Int() int {
 
 // Int64 return int64 value
 func (v Timestamp) Int64() int64 {
-	if !v.Valid() || v.time.Unix() == 0 {
+	if !v.Valid() {
 		return 0
 	}
 	return v.time.Unix()
 }
 
+// Epoch returns the number of seconds since the Unix epoch, and false if Timestamp.ValidFlag is false.
+// Unlike Int64, it tells the epoch itself apart from an invalid value.
+func (v Timestamp) Epoch() (int64, bool) {
+	return v.Int64(), v.Valid()
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 func (v Timestamp) MarshalJSON() ([]byte, error) {
 	if !v.Valid() {
@@ -103,3 +127,89 @@ func (v *Timestamp) UnmarshalJSON(data []byte) error {
 	}
 	return v.Scan(in)
 }
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Timestamp) Equal(x Timestamp) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.time.Equal(x.time)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Timestamp) Compare(x Timestamp, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.time.Before(x.time):
+		return -1
+	case v.time.After(x.time):
+		return 1
+	}
+	return 0
+}
+
+// Since returns the time elapsed since v by DefaultClock, or 0 if v is invalid.
+func (v Timestamp) Since() time.Duration {
+	return sinceClock(v.time, v.ValidFlag)
+}
+
+// IsPast reports whether v is valid and before the current time of DefaultClock.
+func (v Timestamp) IsPast() bool {
+	return isPast(v.time, v.ValidFlag)
+}
+
+// IsFuture reports whether v is valid and after the current time of DefaultClock.
+func (v Timestamp) IsFuture() bool {
+	return isFuture(v.time, v.ValidFlag)
+}
+
+// ValidateWithin returns ErrTimeOutOfRange unless v is within past and future of DefaultClock,
+// and ErrInvalidGenericValue if v is invalid, in the same way as Time.ValidateWithin.
+func (v Timestamp) ValidateWithin(past, future time.Duration) error {
+	return validateWithin(v.time, v.ValidFlag, past, future)
+}
+
+// Or returns the time.Time value, but if Timestamp.ValidFlag is false, returns d.
+func (v Timestamp) Or(d time.Time) time.Time {
+	if !v.Valid() {
+		return d
+	}
+	return v.time
+}
+
+// OrElse returns the time.Time value, but if Timestamp.ValidFlag is false, returns the result of f.
+func (v Timestamp) OrElse(f func() time.Time) time.Time {
+	if !v.Valid() {
+		return f()
+	}
+	return v.time
+}
+
+// Ptr returns a pointer to a copy of the time.Time value, but if Timestamp.ValidFlag is false, returns nil.
+func (v Timestamp) Ptr() *time.Time {
+	if !v.Valid() {
+		return nil
+	}
+	x := v.time
+	return &x
+}
+
+// TimestampFromPtr returns generic.Timestamp holding *p, or an invalid Timestamp if p is nil.
+func TimestampFromPtr(p *time.Time) Timestamp {
+	if p == nil {
+		return Timestamp{}
+	}
+	return Timestamp{ValidFlag: true, time: *p}
+}
+
+// NullTime converts v to sql.NullTime.
+func (v Timestamp) NullTime() sql.NullTime {
+	if !v.Valid() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: v.time, Valid: true}
+}
@@ -0,0 +1,202 @@
+package generic
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// TimestampMicro is a wrapped time type structure
+type TimestampMicro struct {
+	ValidFlag
+	time time.Time
+}
+
+// MarshalTimestampMicro returns generic.TimestampMicro converting of request data
+func MarshalTimestampMicro(x interface{}) (TimestampMicro, error) {
+	v := TimestampMicro{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustTimestampMicro returns generic.TimestampMicro converting of request data
+func MustTimestampMicro(x interface{}) TimestampMicro {
+	v, err := MarshalTimestampMicro(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value returns timestamp with microseconds, but if TimestampMicro.ValidFlag is false, returns nil.
+func (v TimestampMicro) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.time.UnixMicro(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (v *TimestampMicro) Scan(x interface{}) (err error) {
+	v.time, v.ValidFlag, err = asTimestampMicroseconds(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns timestamp int value, but if TimestampMicro.ValidFlag is false, returns nil.
+func (v TimestampMicro) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *TimestampMicro) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// String implements the Stringer interface.
+// It returns an empty string if TimestampMicro.ValidFlag is false.
+func (v TimestampMicro) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	return strconv.FormatInt(v.Int64(), 10)
+}
+
+// Int return int value
+func (v TimestampMicro) Int() int {
+	return int(v.Int64())
+}
+
+// Int64 return int64 value
+func (v TimestampMicro) Int64() int64 {
+	if !v.Valid() {
+		return 0
+	}
+	return v.time.UnixMicro()
+}
+
+// Epoch returns the number of microseconds since the Unix epoch, and false if TimestampMicro.ValidFlag is false.
+// Unlike Int64, it tells the epoch itself apart from an invalid value.
+func (v TimestampMicro) Epoch() (int64, bool) {
+	return v.Int64(), v.Valid()
+}
+
+// Time returns value as time.Time
+func (v TimestampMicro) Time() time.Time {
+	if !v.Valid() {
+		return time.Unix(0, 0)
+	}
+	return v.time
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v TimestampMicro) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return []byte(v.String()), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *TimestampMicro) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var in interface{}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	return v.Scan(in)
+}
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v TimestampMicro) Equal(x TimestampMicro) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.time.Equal(x.time)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v TimestampMicro) Compare(x TimestampMicro, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.time.Before(x.time):
+		return -1
+	case v.time.After(x.time):
+		return 1
+	}
+	return 0
+}
+
+// Since returns the time elapsed since v by DefaultClock, or 0 if v is invalid.
+func (v TimestampMicro) Since() time.Duration {
+	return sinceClock(v.time, v.ValidFlag)
+}
+
+// IsPast reports whether v is valid and before the current time of DefaultClock.
+func (v TimestampMicro) IsPast() bool {
+	return isPast(v.time, v.ValidFlag)
+}
+
+// IsFuture reports whether v is valid and after the current time of DefaultClock.
+func (v TimestampMicro) IsFuture() bool {
+	return isFuture(v.time, v.ValidFlag)
+}
+
+// ValidateWithin returns ErrTimeOutOfRange unless v is within past and future of DefaultClock,
+// and ErrInvalidGenericValue if v is invalid, in the same way as Time.ValidateWithin.
+func (v TimestampMicro) ValidateWithin(past, future time.Duration) error {
+	return validateWithin(v.time, v.ValidFlag, past, future)
+}
+
+// Or returns the time.Time value, but if TimestampMicro.ValidFlag is false, returns d.
+func (v TimestampMicro) Or(d time.Time) time.Time {
+	if !v.Valid() {
+		return d
+	}
+	return v.time
+}
+
+// OrElse returns the time.Time value, but if TimestampMicro.ValidFlag is false, returns the result of f.
+func (v TimestampMicro) OrElse(f func() time.Time) time.Time {
+	if !v.Valid() {
+		return f()
+	}
+	return v.time
+}
+
+// Ptr returns a pointer to a copy of the time.Time value, but if TimestampMicro.ValidFlag is false, returns nil.
+func (v TimestampMicro) Ptr() *time.Time {
+	if !v.Valid() {
+		return nil
+	}
+	x := v.time
+	return &x
+}
+
+// TimestampMicroFromPtr returns generic.TimestampMicro holding *p, or an invalid TimestampMicro if p is nil.
+func TimestampMicroFromPtr(p *time.Time) TimestampMicro {
+	if p == nil {
+		return TimestampMicro{}
+	}
+	return TimestampMicro{ValidFlag: true, time: *p}
+}
+
+// NullTime converts v to sql.NullTime.
+func (v TimestampMicro) NullTime() sql.NullTime {
+	if !v.Valid() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: v.time, Valid: true}
+}
@@ -0,0 +1,301 @@
+package generic
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalTimestampMicro(t *testing.T) {
+	v := time.Now()
+	expected := v.UnixMicro()
+	ts, err := MarshalTimestampMicro(v)
+	if err != nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
+	}
+	if ts.Weak() != expected {
+		t.Errorf("actual:%[1]v(%[1]T), expected:%[2]v(%[2]T)", ts.Weak(), expected)
+	}
+}
+
+func TestMustTimestampMicro(t *testing.T) {
+	v := time.Now()
+	expected := v.UnixMicro()
+	tests := []struct {
+		name      string
+		args      interface{}
+		want      TimestampMicro
+		wantPanic bool
+	}{
+		{
+			name: "valid",
+			args: v,
+			want: TimestampMicro{
+				ValidFlag: true,
+				time:      v,
+			},
+			wantPanic: false,
+		},
+		{
+			name: "panic",
+			args: "valid paramenter",
+			want: TimestampMicro{
+				ValidFlag: false,
+			},
+			wantPanic: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantPanic {
+				p := assert.Panics(t, func() {
+					MustTimestampMicro(tt.args)
+				})
+				if !p {
+					t.Errorf("MustTimestampMicro() panic = %v, want panic %v", p, tt.wantPanic)
+				}
+				return
+			}
+			if got := MustTimestampMicro(tt.args); got.Weak() != expected {
+				t.Errorf("MustTimestampMicro() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimestampMicroJsonMarshal(t *testing.T) {
+	v := time.Now()
+	tm := TimestampMicro{
+		ValidFlag: true,
+		time:      v,
+	}
+	expected := strconv.FormatInt(v.UnixMicro(), 10)
+	actual, err := json.Marshal(tm)
+	if err != nil {
+		t.Errorf("Not Expected error when json.Marshal. error:%v", err.Error())
+	}
+	if string(actual) != expected {
+		t.Errorf("actual:%s, expected:%s", string(actual), expected)
+	}
+}
+
+func TestTimestampMicroJsonMarshalValidFalse(t *testing.T) {
+	tm := TimestampMicro{
+		ValidFlag: false,
+		time:      time.Now(),
+	}
+	expected := []byte("null")
+	actual, err := json.Marshal(tm)
+	if err != nil {
+		t.Errorf("Not Expected error when json.Marshal. error:%v", err.Error())
+	}
+	if string(actual) != string(expected) {
+		t.Errorf("actual:%v, expected:%v", actual, expected)
+	}
+}
+
+func TestTimestampMicroJsonUnmarshal(t *testing.T) {
+	v := time.Now()
+	in, _ := v.MarshalJSON()
+	tm := TimestampMicro{}
+	if err := tm.UnmarshalJSON(in); err != nil {
+		t.Errorf("Not Expected error when json.Unmarshal. error:%v", err.Error())
+	}
+	if !tm.Valid() {
+		t.Error("ValidFlag should be TRUE")
+	}
+	if tm.Int64() != v.UnixMicro() {
+		t.Errorf("actual:%d, expected:%d", tm.Int64(), v.UnixMicro())
+	}
+}
+
+func TestTimestampMicroJsonUnmarshalNil(t *testing.T) {
+	tm := TimestampMicro{}
+	if err := tm.UnmarshalJSON(nil); err != nil {
+		t.Errorf("Not Expected error when json.Unmarshal. error:%v", err.Error())
+	}
+	if tm.Valid() {
+		t.Error("ValidFlag should be FALSE")
+	}
+	if tm.Int64() != 0 {
+		t.Errorf("actual:%d, expected:%d", tm.Int64(), 0)
+	}
+}
+
+func TestTimestampMicroJsonUnmarshalInvalid(t *testing.T) {
+	tm := TimestampMicro{}
+	if err := tm.UnmarshalJSON([]byte(`"a`)); err == nil {
+		t.Errorf("Expected error when json.Unmarshal, but not; %#v", tm)
+	}
+}
+
+func TestTimestampMicroSetNil(t *testing.T) {
+	tm := TimestampMicro{}
+	err := tm.Set(nil)
+	if err != nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
+	}
+	if _, err = tm.Value(); err != nil {
+		t.Errorf("This value should return nil. error:%s", err.Error())
+	}
+}
+
+func TestTimestampMicroSetTime(t *testing.T) {
+	v := time.Now()
+	expected := v
+	tm := TimestampMicro{}
+	err := tm.Set(v)
+	if err != nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
+	}
+	if tm.Weak() != expected.UnixMicro() {
+		t.Errorf("actual:%v, expected:%v", tm.Weak(), expected)
+	}
+}
+
+func TestTimestampMicroSetInt64(t *testing.T) {
+	var v int64 = 1367059792
+	expected := time.UnixMicro(v)
+	tm := TimestampMicro{}
+	err := tm.Set(v)
+	if err != nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
+	}
+	if tm.Weak() != expected.UnixMicro() {
+		t.Errorf("actual:%v, expected:%v", tm.Weak(), expected)
+	}
+}
+
+func TestTimestampMicroSetNumericString(t *testing.T) {
+	v := "1467059792"
+	tm := TimestampMicro{}
+	err := tm.Set(v)
+	if err != nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
+	}
+	if tm.Weak() != int64(1467059792) {
+		t.Errorf("actual:%#v, expected:%#v", tm.Weak(), int64(1467059792))
+	}
+}
+
+func TestTimestampMicroSetNonNumericString(t *testing.T) {
+	v := "a"
+	tm := TimestampMicro{}
+	err := tm.Set(v)
+	if err == nil {
+		t.Error("Expected error.")
+	}
+	if tm.Weak() != nil {
+		t.Errorf("This value should return nil. value:%#v", tm.Weak())
+	}
+}
+
+func TestTimestampMicroSetBool(t *testing.T) {
+	v := true
+	tm := TimestampMicro{}
+	err := tm.Set(v)
+	if err == nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
+	}
+	if tm.Weak() != nil {
+		t.Errorf("This value should return nil. value:%#v", tm.Weak())
+	}
+}
+
+func TestTimestampMicroInt64(t *testing.T) {
+	v := time.Now()
+	expected := v.UnixMicro()
+	tm := TimestampMicro{}
+	err := tm.Set(v)
+	if err != nil {
+		t.Error("Not expected error.")
+	}
+	if tm.Int64() != expected {
+		t.Errorf("This value should return %d. value:%d", expected, tm.Int())
+	}
+}
+
+func TestTimestampMicroInt64Zero(t *testing.T) {
+	v := time.Unix(0, 0)
+	var expected int64
+	tm := TimestampMicro{}
+	err := tm.Set(v)
+	if err != nil {
+		t.Error("Not expected error.")
+	}
+	if tm.Int64() != expected {
+		t.Errorf("This value should return %d. value:%d", expected, tm.Int())
+	}
+}
+
+func TestTimestampMicroInt(t *testing.T) {
+	v := time.Now()
+	expected := int(v.UnixMicro())
+	tm := TimestampMicro{}
+	err := tm.Set(v)
+	if err != nil {
+		t.Error("Not expected error.")
+	}
+	if tm.Int() != expected {
+		t.Errorf("This value should return %d. value:%d", expected, tm.Int())
+	}
+}
+
+func TestTimestampMicroString(t *testing.T) {
+	v := time.Now()
+	expected := strconv.FormatInt(v.UnixMicro(), 10)
+	tm := TimestampMicro{}
+	err := tm.Set(v)
+	if err != nil {
+		t.Error("Not expected error.")
+	}
+	if tm.String() != expected {
+		t.Errorf("This value should return %s. value:%s", expected, tm.String())
+	}
+}
+
+func TestTimestampMicro_Time(t *testing.T) {
+	now := time.Now()
+
+	type fields struct {
+		ValidFlag ValidFlag
+		time      time.Time
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   time.Time
+	}{
+		{
+			name: "now",
+			fields: fields{
+				ValidFlag: true,
+				time:      now,
+			},
+			want: now,
+		},
+		{
+			name: "invalid",
+			fields: fields{
+				ValidFlag: false,
+				time:      now,
+			},
+			want: time.Unix(0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := TimestampMicro{
+				ValidFlag: tt.fields.ValidFlag,
+				time:      tt.fields.time,
+			}
+			if got := v.Time(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TimestampMicro.Time() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
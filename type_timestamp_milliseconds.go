@@ -1,6 +1,7 @@
 package generic
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"strconv"
@@ -34,7 +35,7 @@ func (v TimestampMS) Value() (driver.Value, error) {
 	if !v.Valid() {
 		return nil, nil
 	}
-	return v.time.UnixNano() / 1000000, nil
+	return v.time.UnixMilli(), nil
 }
 
 // Scan implements the sql.Scanner interface.
@@ -59,7 +60,11 @@ func (v *TimestampMS) Set(x interface{}) (err error) {
 }
 
 // String implements the Stringer interface.
+// It returns an empty string if TimestampMS.ValidFlag is false.
 func (v TimestampMS) String() string {
+	if !v.Valid() {
+		return ""
+	}
 	return strconv.FormatInt(v.Int64(), 10)
 }
 
@@ -70,10 +75,16 @@ func (v TimestampMS) Int() int {
 
 // Int64 return int64 value
 func (v TimestampMS) Int64() int64 {
-	if !v.Valid() || v.time.UnixNano() == 0 {
+	if !v.Valid() {
 		return 0
 	}
-	return v.time.UnixNano() / 1000000
+	return v.time.UnixMilli()
+}
+
+// Epoch returns the number of milliseconds since the Unix epoch, and false if TimestampMS.ValidFlag is false.
+// Unlike Int64, it tells the epoch itself apart from an invalid value.
+func (v TimestampMS) Epoch() (int64, bool) {
+	return v.Int64(), v.Valid()
 }
 
 // Time returns value as time.Time
@@ -103,3 +114,89 @@ func (v *TimestampMS) UnmarshalJSON(data []byte) error {
 	}
 	return v.Scan(in)
 }
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v TimestampMS) Equal(x TimestampMS) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.time.Equal(x.time)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v TimestampMS) Compare(x TimestampMS, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.time.Before(x.time):
+		return -1
+	case v.time.After(x.time):
+		return 1
+	}
+	return 0
+}
+
+// Since returns the time elapsed since v by DefaultClock, or 0 if v is invalid.
+func (v TimestampMS) Since() time.Duration {
+	return sinceClock(v.time, v.ValidFlag)
+}
+
+// IsPast reports whether v is valid and before the current time of DefaultClock.
+func (v TimestampMS) IsPast() bool {
+	return isPast(v.time, v.ValidFlag)
+}
+
+// IsFuture reports whether v is valid and after the current time of DefaultClock.
+func (v TimestampMS) IsFuture() bool {
+	return isFuture(v.time, v.ValidFlag)
+}
+
+// ValidateWithin returns ErrTimeOutOfRange unless v is within past and future of DefaultClock,
+// and ErrInvalidGenericValue if v is invalid, in the same way as Time.ValidateWithin.
+func (v TimestampMS) ValidateWithin(past, future time.Duration) error {
+	return validateWithin(v.time, v.ValidFlag, past, future)
+}
+
+// Or returns the time.Time value, but if TimestampMS.ValidFlag is false, returns d.
+func (v TimestampMS) Or(d time.Time) time.Time {
+	if !v.Valid() {
+		return d
+	}
+	return v.time
+}
+
+// OrElse returns the time.Time value, but if TimestampMS.ValidFlag is false, returns the result of f.
+func (v TimestampMS) OrElse(f func() time.Time) time.Time {
+	if !v.Valid() {
+		return f()
+	}
+	return v.time
+}
+
+// Ptr returns a pointer to a copy of the time.Time value, but if TimestampMS.ValidFlag is false, returns nil.
+func (v TimestampMS) Ptr() *time.Time {
+	if !v.Valid() {
+		return nil
+	}
+	x := v.time
+	return &x
+}
+
+// TimestampMSFromPtr returns generic.TimestampMS holding *p, or an invalid TimestampMS if p is nil.
+func TimestampMSFromPtr(p *time.Time) TimestampMS {
+	if p == nil {
+		return TimestampMS{}
+	}
+	return TimestampMS{ValidFlag: true, time: *p}
+}
+
+// NullTime converts v to sql.NullTime.
+func (v TimestampMS) NullTime() sql.NullTime {
+	if !v.Valid() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: v.time, Valid: true}
+}
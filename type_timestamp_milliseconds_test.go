@@ -174,11 +174,11 @@ func TestTimestampMSSetNumericString(t *testing.T) {
 	v := "1467059792"
 	tm := TimestampMS{}
 	err := tm.Set(v)
-	if err == nil {
-		t.Errorf("Expected error.")
+	if err != nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
 	}
-	if tm.Weak() != nil {
-		t.Errorf("This value should return nil. value:%#v", tm.Weak())
+	if tm.Weak() != int64(1467059792) {
+		t.Errorf("actual:%#v, expected:%#v", tm.Weak(), int64(1467059792))
 	}
 }
 
@@ -1,6 +1,7 @@
 package generic
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"strconv"
@@ -59,7 +60,11 @@ func (v *TimestampNano) Set(x interface{}) (err error) {
 }
 
 // String implements the Stringer interface.
+// It returns an empty string if TimestampNano.ValidFlag is false.
 func (v TimestampNano) String() string {
+	if !v.Valid() {
+		return ""
+	}
 	return strconv.FormatInt(v.Int64(), 10)
 }
 
@@ -70,12 +75,18 @@ func (v TimestampNano) Int() int {
 
 // Int64 return int64 value
 func (v TimestampNano) Int64() int64 {
-	if !v.Valid() || v.time.UnixNano() == 0 {
+	if !v.Valid() {
 		return 0
 	}
 	return v.time.UnixNano()
 }
 
+// Epoch returns the number of nanoseconds since the Unix epoch, and false if TimestampNano.ValidFlag is false.
+// Unlike Int64, it tells the epoch itself apart from an invalid value.
+func (v TimestampNano) Epoch() (int64, bool) {
+	return v.Int64(), v.Valid()
+}
+
 // Time returns value as time.Time
 func (v TimestampNano) Time() time.Time {
 	if !v.Valid() {
@@ -103,3 +114,89 @@ func (v *TimestampNano) UnmarshalJSON(data []byte) error {
 	}
 	return v.Scan(in)
 }
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v TimestampNano) Equal(x TimestampNano) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.time.Equal(x.time)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v TimestampNano) Compare(x TimestampNano, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.time.Before(x.time):
+		return -1
+	case v.time.After(x.time):
+		return 1
+	}
+	return 0
+}
+
+// Since returns the time elapsed since v by DefaultClock, or 0 if v is invalid.
+func (v TimestampNano) Since() time.Duration {
+	return sinceClock(v.time, v.ValidFlag)
+}
+
+// IsPast reports whether v is valid and before the current time of DefaultClock.
+func (v TimestampNano) IsPast() bool {
+	return isPast(v.time, v.ValidFlag)
+}
+
+// IsFuture reports whether v is valid and after the current time of DefaultClock.
+func (v TimestampNano) IsFuture() bool {
+	return isFuture(v.time, v.ValidFlag)
+}
+
+// ValidateWithin returns ErrTimeOutOfRange unless v is within past and future of DefaultClock,
+// and ErrInvalidGenericValue if v is invalid, in the same way as Time.ValidateWithin.
+func (v TimestampNano) ValidateWithin(past, future time.Duration) error {
+	return validateWithin(v.time, v.ValidFlag, past, future)
+}
+
+// Or returns the time.Time value, but if TimestampNano.ValidFlag is false, returns d.
+func (v TimestampNano) Or(d time.Time) time.Time {
+	if !v.Valid() {
+		return d
+	}
+	return v.time
+}
+
+// OrElse returns the time.Time value, but if TimestampNano.ValidFlag is false, returns the result of f.
+func (v TimestampNano) OrElse(f func() time.Time) time.Time {
+	if !v.Valid() {
+		return f()
+	}
+	return v.time
+}
+
+// Ptr returns a pointer to a copy of the time.Time value, but if TimestampNano.ValidFlag is false, returns nil.
+func (v TimestampNano) Ptr() *time.Time {
+	if !v.Valid() {
+		return nil
+	}
+	x := v.time
+	return &x
+}
+
+// TimestampNanoFromPtr returns generic.TimestampNano holding *p, or an invalid TimestampNano if p is nil.
+func TimestampNanoFromPtr(p *time.Time) TimestampNano {
+	if p == nil {
+		return TimestampNano{}
+	}
+	return TimestampNano{ValidFlag: true, time: *p}
+}
+
+// NullTime converts v to sql.NullTime.
+func (v TimestampNano) NullTime() sql.NullTime {
+	if !v.Valid() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: v.time, Valid: true}
+}
@@ -177,11 +177,11 @@ func TestTimestampNanoSetNumericString(t *testing.T) {
 	v := "1467059792"
 	tn := TimestampNano{}
 	err := tn.Set(v)
-	if err == nil {
-		t.Errorf("Expected error.")
+	if err != nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
 	}
-	if tn.Weak() != nil {
-		t.Errorf("This value should return nil. value:%#v", tn.Weak())
+	if tn.Weak() != int64(1467059792) {
+		t.Errorf("actual:%#v, expected:%#v", tn.Weak(), int64(1467059792))
 	}
 }
 
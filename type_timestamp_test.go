@@ -177,11 +177,11 @@ func TestTimestampSetNumericString(t *testing.T) {
 	v := "1467059792"
 	ts := Timestamp{}
 	err := ts.Set(v)
-	if err == nil {
-		t.Errorf("Expected error.")
+	if err != nil {
+		t.Errorf("Not Expected error. error:%s", err.Error())
 	}
-	if ts.Weak() != nil {
-		t.Errorf("This value should return nil. actual:%#v", ts.Weak())
+	if ts.Weak() != time.Unix(1467059792, 0) {
+		t.Errorf("actual:%#v, expected:%#v", ts.Weak(), time.Unix(1467059792, 0))
 	}
 }
 
@@ -105,3 +105,143 @@ func (v *Uint) UnmarshalJSON(data []byte) error {
 	}
 	return v.Scan(in)
 }
+
+// Add returns v + x.
+// If either operand is invalid, Add returns an invalid Uint.
+func (v Uint) Add(x Uint) (Uint, error) {
+	if !v.Valid() || !x.Valid() {
+		return Uint{}, nil
+	}
+	r := v.uint + x.uint
+	if r < v.uint {
+		return Uint{}, ErrOverflow
+	}
+	return Uint{ValidFlag: true, uint: r}, nil
+}
+
+// Sub returns v - x.
+// If either operand is invalid, Sub returns an invalid Uint.
+func (v Uint) Sub(x Uint) (Uint, error) {
+	if !v.Valid() || !x.Valid() {
+		return Uint{}, nil
+	}
+	if x.uint > v.uint {
+		return Uint{}, ErrOverflow
+	}
+	return Uint{ValidFlag: true, uint: v.uint - x.uint}, nil
+}
+
+// Mul returns v * x.
+// If either operand is invalid, Mul returns an invalid Uint.
+func (v Uint) Mul(x Uint) (Uint, error) {
+	if !v.Valid() || !x.Valid() {
+		return Uint{}, nil
+	}
+	r := v.uint * x.uint
+	if v.uint != 0 && r/v.uint != x.uint {
+		return Uint{}, ErrOverflow
+	}
+	return Uint{ValidFlag: true, uint: r}, nil
+}
+
+// Div returns v / x.
+// If either operand is invalid, Div returns an invalid Uint.
+func (v Uint) Div(x Uint) (Uint, error) {
+	if !v.Valid() || !x.Valid() {
+		return Uint{}, nil
+	}
+	if x.uint == 0 {
+		return Uint{}, ErrDivideByZero
+	}
+	return Uint{ValidFlag: true, uint: v.uint / x.uint}, nil
+}
+
+// Mod returns the remainder of v / x.
+// If either operand is invalid, Mod returns an invalid Uint.
+func (v Uint) Mod(x Uint) (Uint, error) {
+	if !v.Valid() || !x.Valid() {
+		return Uint{}, nil
+	}
+	if x.uint == 0 {
+		return Uint{}, ErrDivideByZero
+	}
+	return Uint{ValidFlag: true, uint: v.uint % x.uint}, nil
+}
+
+// Neg returns -v. Only zero can be negated without overflow.
+// If v is invalid, Neg returns an invalid Uint.
+func (v Uint) Neg() (Uint, error) {
+	if !v.Valid() {
+		return Uint{}, nil
+	}
+	if v.uint != 0 {
+		return Uint{}, ErrOverflow
+	}
+	return v, nil
+}
+
+// Abs returns the absolute value of v, which is v itself.
+// If v is invalid, Abs returns an invalid Uint.
+func (v Uint) Abs() (Uint, error) {
+	if !v.Valid() {
+		return Uint{}, nil
+	}
+	return v, nil
+}
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Uint) Equal(x Uint) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.uint == x.uint
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v Uint) Compare(x Uint, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.uint < x.uint:
+		return -1
+	case v.uint > x.uint:
+		return 1
+	}
+	return 0
+}
+
+// Or returns the uint64 value, but if Uint.ValidFlag is false, returns d.
+func (v Uint) Or(d uint64) uint64 {
+	if !v.Valid() {
+		return d
+	}
+	return v.uint
+}
+
+// OrElse returns the uint64 value, but if Uint.ValidFlag is false, returns the result of f.
+func (v Uint) OrElse(f func() uint64) uint64 {
+	if !v.Valid() {
+		return f()
+	}
+	return v.uint
+}
+
+// Ptr returns a pointer to a copy of the uint64 value, but if Uint.ValidFlag is false, returns nil.
+func (v Uint) Ptr() *uint64 {
+	if !v.Valid() {
+		return nil
+	}
+	x := v.uint
+	return &x
+}
+
+// UintFromPtr returns generic.Uint holding *p, or an invalid Uint if p is nil.
+func UintFromPtr(p *uint64) Uint {
+	if p == nil {
+		return Uint{}
+	}
+	return Uint{ValidFlag: true, uint: *p}
+}
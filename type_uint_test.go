@@ -2,6 +2,7 @@ package generic
 
 import (
 	"encoding/json"
+	"math"
 	"reflect"
 	"testing"
 
@@ -249,3 +250,41 @@ func TestUintStringInvalid(t *testing.T) {
 		t.Errorf("expected empty string, actual:%s", ti.String())
 	}
 }
+
+func TestUintArithmetic(t *testing.T) {
+	u := func(n uint64) Uint { return Uint{ValidFlag: true, uint: n} }
+	tests := []struct {
+		name    string
+		f       func() (Uint, error)
+		want    Uint
+		wantErr error
+	}{
+		{name: "add", f: func() (Uint, error) { return u(1).Add(u(2)) }, want: u(3)},
+		{name: "add invalid", f: func() (Uint, error) { return Uint{}.Add(u(2)) }, want: Uint{}},
+		{name: "add overflow", f: func() (Uint, error) { return u(math.MaxUint64).Add(u(1)) }, wantErr: ErrOverflow},
+		{name: "sub", f: func() (Uint, error) { return u(3).Sub(u(2)) }, want: u(1)},
+		{name: "sub underflow", f: func() (Uint, error) { return u(2).Sub(u(3)) }, wantErr: ErrOverflow},
+		{name: "mul", f: func() (Uint, error) { return u(4).Mul(u(3)) }, want: u(12)},
+		{name: "mul overflow", f: func() (Uint, error) { return u(math.MaxUint64 / 2).Mul(u(3)) }, wantErr: ErrOverflow},
+		{name: "div", f: func() (Uint, error) { return u(7).Div(u(2)) }, want: u(3)},
+		{name: "div by zero", f: func() (Uint, error) { return u(7).Div(u(0)) }, wantErr: ErrDivideByZero},
+		{name: "mod", f: func() (Uint, error) { return u(7).Mod(u(2)) }, want: u(1)},
+		{name: "mod by zero", f: func() (Uint, error) { return u(7).Mod(u(0)) }, wantErr: ErrDivideByZero},
+		{name: "neg zero", f: func() (Uint, error) { return u(0).Neg() }, want: u(0)},
+		{name: "neg overflow", f: func() (Uint, error) { return u(1).Neg() }, wantErr: ErrOverflow},
+		{name: "abs", f: func() (Uint, error) { return u(5).Abs() }, want: u(5)},
+		{name: "abs invalid", f: func() (Uint, error) { return Uint{}.Abs() }, want: Uint{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f()
+			if err != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"net/url"
+	"strings"
 )
 
 // URL is generic url.URL type structure
@@ -178,3 +179,46 @@ func (v URL) ResolveReference(ref *url.URL) URL {
 	}
 	return v
 }
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v URL) Equal(x URL) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.String() == x.String()
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v URL) Compare(x URL, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	return strings.Compare(v.String(), x.String())
+}
+
+// Validate returns ErrURLPolicyViolation if v breaks p.
+// An invalid value is rejected with ErrInvalidGenericValue, so a NULL never passes a policy.
+func (v URL) Validate(p URLPolicy) error {
+	if !v.Valid() || v.url == nil {
+		return ErrInvalidGenericValue{Value: nil}
+	}
+	return p.Check(v.url)
+}
+
+// Or returns the *url.URL value, but if URL.ValidFlag is false, returns d.
+func (v URL) Or(d *url.URL) *url.URL {
+	if !v.Valid() || v.url == nil {
+		return d
+	}
+	return v.url
+}
+
+// OrElse returns the *url.URL value, but if URL.ValidFlag is false, returns the result of f.
+func (v URL) OrElse(f func() *url.URL) *url.URL {
+	if !v.Valid() || v.url == nil {
+		return f()
+	}
+	return v.url
+}
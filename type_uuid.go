@@ -0,0 +1,234 @@
+package generic
+
+import (
+	"bytes"
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// UUIDVariant is the variant of a UUID defined in RFC 4122
+type UUIDVariant int
+
+const (
+	// UUIDVariantInvalid is the variant of an invalid UUID
+	UUIDVariantInvalid UUIDVariant = iota
+	// UUIDVariantNCS is reserved for NCS backward compatibility
+	UUIDVariantNCS
+	// UUIDVariantRFC4122 is the variant specified in RFC 4122
+	UUIDVariantRFC4122
+	// UUIDVariantMicrosoft is reserved for Microsoft backward compatibility
+	UUIDVariantMicrosoft
+	// UUIDVariantFuture is reserved for future definition
+	UUIDVariantFuture
+)
+
+// UUID is generic UUID type structure
+type UUID struct {
+	ValidFlag
+	uuid [16]byte
+}
+
+// MarshalUUID return generic.UUID converting of request data
+func MarshalUUID(x interface{}) (UUID, error) {
+	v := UUID{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustUUID return generic.UUID converting of request data
+func MustUUID(x interface{}) UUID {
+	v, err := MarshalUUID(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewUUIDv4 returns a random UUID of version 4
+func NewUUIDv4() (UUID, error) {
+	v := UUID{ValidFlag: true}
+	if _, err := rand.Read(v.uuid[:]); err != nil {
+		return UUID{}, err
+	}
+	v.setVersion(4)
+	return v, nil
+}
+
+// NewUUIDv7 returns a time-ordered UUID of version 7 holding the current Unix time in milliseconds
+func NewUUIDv7() (UUID, error) {
+	v := UUID{ValidFlag: true}
+	if _, err := rand.Read(v.uuid[6:]); err != nil {
+		return UUID{}, err
+	}
+	var ms [8]byte
+	binary.BigEndian.PutUint64(ms[:], uint64(DefaultClock.Now().UnixMilli()))
+	copy(v.uuid[:6], ms[2:])
+	v.setVersion(7)
+	return v, nil
+}
+
+func (v *UUID) setVersion(ver byte) {
+	v.uuid[6] = v.uuid[6]&0x0f | ver<<4
+	v.uuid[8] = v.uuid[8]&0x3f | 0x80
+}
+
+// Value implements the driver Valuer interface.
+// The value is the canonical string form; use Bytes for binary columns.
+func (v UUID) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be 16 raw bytes, or a canonical, hyphenless, braced or urn:uuid: string.
+func (v *UUID) Scan(x interface{}) (err error) {
+	v.uuid, v.ValidFlag, err = asUUID(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns the canonical string, but if UUID.ValidFlag is false, returns nil.
+func (v UUID) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *UUID) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// String implements the Stringer interface.
+// It returns the canonical form such as "f47ac10b-58cc-4372-a567-0e02b2c3d479".
+func (v UUID) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	var buf [36]byte
+	hex.Encode(buf[0:8], v.uuid[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], v.uuid[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], v.uuid[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], v.uuid[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], v.uuid[10:])
+	return string(buf[:])
+}
+
+// Bytes returns the 16 bytes of the UUID, but if UUID.ValidFlag is false, returns nil.
+func (v UUID) Bytes() []byte {
+	if !v.Valid() {
+		return nil
+	}
+	b := make([]byte, 16)
+	copy(b, v.uuid[:])
+	return b
+}
+
+// Array returns the UUID as [16]byte
+func (v UUID) Array() [16]byte {
+	if !v.Valid() {
+		return [16]byte{}
+	}
+	return v.uuid
+}
+
+// Version returns the version of the UUID, or 0 if UUID.ValidFlag is false.
+func (v UUID) Version() int {
+	if !v.Valid() {
+		return 0
+	}
+	return int(v.uuid[6] >> 4)
+}
+
+// Variant returns the variant of the UUID.
+func (v UUID) Variant() UUIDVariant {
+	switch {
+	case !v.Valid():
+		return UUIDVariantInvalid
+	case v.uuid[8]&0x80 == 0x00:
+		return UUIDVariantNCS
+	case v.uuid[8]&0xc0 == 0x80:
+		return UUIDVariantRFC4122
+	case v.uuid[8]&0xe0 == 0xc0:
+		return UUIDVariantMicrosoft
+	}
+	return UUIDVariantFuture
+}
+
+// Equal reports whether v and x are the same value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v UUID) Equal(x UUID) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.uuid == x.uuid
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// o decides whether invalid values are ordered before or after valid values.
+func (v UUID) Compare(x UUID, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	return bytes.Compare(v.uuid[:], x.uuid[:])
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v UUID) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return []byte(`"` + v.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *UUID) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var in interface{}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	return v.Scan(in)
+}
+
+// parseUUID parses the canonical, hyphenless, braced and urn:uuid: forms of a UUID.
+func parseUUID(s string) (result [16]byte, ok bool) {
+	switch len(s) {
+	case 32:
+		_, err := hex.Decode(result[:], []byte(s))
+		return result, err == nil
+	case 36:
+	case 38:
+		if s[0] != '{' || s[37] != '}' {
+			return result, false
+		}
+		s = s[1:37]
+	case 45:
+		if !strings.EqualFold(s[:9], "urn:uuid:") {
+			return result, false
+		}
+		s = s[9:]
+	default:
+		return result, false
+	}
+	if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return result, false
+	}
+	h := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	_, err := hex.Decode(result[:], []byte(h))
+	return result, err == nil
+}
@@ -0,0 +1,122 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+const testUUIDString = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+
+var testUUIDBytes = []byte{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}
+
+func TestMarshalUUID(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    interface{}
+		wantErr bool
+	}{
+		{name: "canonical", args: testUUIDString},
+		{name: "upper case", args: "F47AC10B-58CC-4372-A567-0E02B2C3D479"},
+		{name: "hyphenless", args: "f47ac10b58cc4372a5670e02b2c3d479"},
+		{name: "braced", args: "{f47ac10b-58cc-4372-a567-0e02b2c3d479}"},
+		{name: "urn", args: "urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{name: "binary", args: testUUIDBytes},
+		{name: "text bytes", args: []byte(testUUIDString)},
+		{name: "array", args: [16]byte{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}},
+		{name: "misplaced hyphen", args: "f47ac10b5-8cc-4372-a567-0e02b2c3d479", wantErr: true},
+		{name: "bad brace", args: "(f47ac10b-58cc-4372-a567-0e02b2c3d479)", wantErr: true},
+		{name: "not hex", args: "g47ac10b-58cc-4372-a567-0e02b2c3d479", wantErr: true},
+		{name: "short bytes", args: []byte{1, 2, 3}, wantErr: true},
+		{name: "int", args: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalUUID(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalUUID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if got.Valid() {
+					t.Error("MarshalUUID() valid after error")
+				}
+				return
+			}
+			if got.String() != testUUIDString {
+				t.Errorf("MarshalUUID() = %v, want %v", got, testUUIDString)
+			}
+		})
+	}
+}
+
+func TestUUIDVersionAndVariant(t *testing.T) {
+	v := MustUUID(testUUIDString)
+	if v.Version() != 4 {
+		t.Errorf("UUID.Version() = %v, want 4", v.Version())
+	}
+	if v.Variant() != UUIDVariantRFC4122 {
+		t.Errorf("UUID.Variant() = %v, want %v", v.Variant(), UUIDVariantRFC4122)
+	}
+	if (UUID{}).Variant() != UUIDVariantInvalid {
+		t.Errorf("UUID.Variant() = %v, want %v", (UUID{}).Variant(), UUIDVariantInvalid)
+	}
+}
+
+func TestNewUUIDv4(t *testing.T) {
+	a, err := NewUUIDv4()
+	if err != nil {
+		t.Fatalf("NewUUIDv4() error = %v", err)
+	}
+	b, _ := NewUUIDv4()
+	if a.Version() != 4 || a.Variant() != UUIDVariantRFC4122 {
+		t.Errorf("NewUUIDv4() = %v, version %d, variant %v", a, a.Version(), a.Variant())
+	}
+	if a.Equal(b) {
+		t.Errorf("NewUUIDv4() returned %v twice", a)
+	}
+}
+
+func TestNewUUIDv7(t *testing.T) {
+	before := time.Now().UnixNano() / int64(time.Millisecond)
+	v, err := NewUUIDv7()
+	if err != nil {
+		t.Fatalf("NewUUIDv7() error = %v", err)
+	}
+	if v.Version() != 7 || v.Variant() != UUIDVariantRFC4122 {
+		t.Errorf("NewUUIDv7() = %v, version %d, variant %v", v, v.Version(), v.Variant())
+	}
+	b := v.Bytes()
+	ms := int64(b[0])<<40 | int64(b[1])<<32 | int64(b[2])<<24 | int64(b[3])<<16 | int64(b[4])<<8 | int64(b[5])
+	if ms < before || ms > before+1000 {
+		t.Errorf("NewUUIDv7() timestamp = %d, want about %d", ms, before)
+	}
+}
+
+func TestUUIDJSON(t *testing.T) {
+	var s struct {
+		ID    UUID `json:"id"`
+		Empty UUID `json:"empty"`
+	}
+	if err := json.Unmarshal([]byte(`{"id":"{F47AC10B-58CC-4372-A567-0E02B2C3D479}","empty":null}`), &s); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"id":"` + testUUIDString + `","empty":null}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+}
+
+func TestUUIDValue(t *testing.T) {
+	v := MustUUID(testUUIDBytes)
+	dv, err := v.Value()
+	if err != nil || dv != testUUIDString {
+		t.Errorf("UUID.Value() = %v, %v", dv, err)
+	}
+	dv, err = UUID{}.Value()
+	if err != nil || dv != nil {
+		t.Errorf("UUID.Value() = %v, %v, want nil", dv, err)
+	}
+}
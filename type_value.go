@@ -0,0 +1,196 @@
+package generic
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Kind is the kind of value held by generic.Value.
+type Kind int
+
+// Kinds of generic.Value
+const (
+	KindNull Kind = iota
+	KindBool
+	KindInt
+	KindUint
+	KindFloat
+	KindString
+	KindTime
+	KindBytes
+)
+
+var kindNames = [...]string{
+	KindNull:   "null",
+	KindBool:   "bool",
+	KindInt:    "int",
+	KindUint:   "uint",
+	KindFloat:  "float",
+	KindString: "string",
+	KindTime:   "time",
+	KindBytes:  "bytes",
+}
+
+// String implements the Stringer interface.
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return "Kind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return kindNames[k]
+}
+
+// Value is generic dynamic type structure.
+// It keeps the scanned value as it is, and converts it on demand.
+type Value struct {
+	ValidFlag
+	value interface{}
+	kind  Kind
+}
+
+// MarshalValue return generic.Value converting of request data
+func MarshalValue(x interface{}) (Value, error) {
+	v := Value{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustValue return generic.Value converting of request data
+func MustValue(x interface{}) Value {
+	v, err := MarshalValue(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+func (v Value) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.value, nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (v *Value) Scan(x interface{}) (err error) {
+	v.value, v.kind, err = asValue(x)
+	v.ValidFlag = v.kind != KindNull
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns the held value, but if Value.ValidFlag is false, returns nil.
+func (v Value) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *Value) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Kind returns the kind of the held value.
+func (v Value) Kind() Kind {
+	if !v.Valid() {
+		return KindNull
+	}
+	return v.kind
+}
+
+// AsBool converts the held value to generic.Bool.
+func (v Value) AsBool() (Bool, error) {
+	return MarshalBool(v.coercible())
+}
+
+// AsInt converts the held value to generic.Int.
+func (v Value) AsInt() (Int, error) {
+	return MarshalInt(v.coercible())
+}
+
+// AsFloat converts the held value to generic.Float.
+func (v Value) AsFloat() (Float, error) {
+	return MarshalFloat(v.coercible())
+}
+
+// AsString converts the held value to generic.String.
+func (v Value) AsString() (String, error) {
+	if v.Kind() == KindTime {
+		return MarshalString(v.value.(time.Time).Format(time.RFC3339Nano))
+	}
+	return MarshalString(v.coercible())
+}
+
+// AsTime converts the held value to generic.Time.
+func (v Value) AsTime() (Time, error) {
+	return MarshalTime(v.coercible())
+}
+
+// coercible returns the held value in the form accepted by the converters.
+// Bytes are passed as string, because drivers return text columns as []byte.
+func (v Value) coercible() interface{} {
+	if !v.Valid() {
+		return nil
+	}
+	if v.kind == KindBytes {
+		return string(v.value.([]byte))
+	}
+	return v.value
+}
+
+// String implements the Stringer interface.
+func (v Value) String() string {
+	s, err := v.AsString()
+	if err != nil {
+		return ""
+	}
+	return s.String()
+}
+
+// Equal reports whether v and x hold the same kind and value.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v Value) Equal(x Value) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	if v.kind != x.kind {
+		return false
+	}
+	switch v.kind {
+	case KindBytes:
+		return bytes.Equal(v.value.([]byte), x.value.([]byte))
+	case KindTime:
+		return v.value.(time.Time).Equal(x.value.(time.Time))
+	}
+	return v.value == x.value
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Bytes are written as a JSON string like AsString, not base64, because drivers return text as []byte.
+func (v Value) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return json.Marshal(v.coercible())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Numbers are kept as int or uint when they are integral, and objects and arrays are rejected.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var in interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&in); err != nil {
+		return err
+	}
+	return v.Scan(in)
+}
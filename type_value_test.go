@@ -0,0 +1,157 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestMarshalValueKind(t *testing.T) {
+	ts := time.Date(2020, 7, 24, 20, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		args    interface{}
+		want    Kind
+		wantErr bool
+	}{
+		{name: "nil", args: nil, want: KindNull},
+		{name: "bool", args: true, want: KindBool},
+		{name: "int8", args: int8(-1), want: KindInt},
+		{name: "uint", args: uint(1), want: KindUint},
+		{name: "float32", args: float32(1.5), want: KindFloat},
+		{name: "string", args: "a", want: KindString},
+		{name: "time", args: ts, want: KindTime},
+		{name: "bytes", args: []byte("a"), want: KindBytes},
+		{name: "nil bytes", args: []byte(nil), want: KindNull},
+		{name: "generic int", args: MustInt(1), want: KindInt},
+		{name: "invalid generic", args: Int{}, want: KindNull},
+		{name: "slice", args: []int{1}, want: KindNull, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalValue(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Kind() != tt.want {
+				t.Errorf("Value.Kind() = %v, want %v", got.Kind(), tt.want)
+			}
+			if got.Valid() != (tt.want != KindNull) {
+				t.Errorf("Value.Valid() = %v", got.Valid())
+			}
+		})
+	}
+}
+
+func TestValueUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    Kind
+		wantErr bool
+	}{
+		{data: `1`, want: KindInt},
+		{data: `18446744073709551615`, want: KindUint},
+		{data: `1.5`, want: KindFloat},
+		{data: `"3"`, want: KindString},
+		{data: `false`, want: KindBool},
+		{data: `null`, want: KindNull},
+		{data: `{}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var v Value
+			err := json.Unmarshal([]byte(tt.data), &v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Value.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if v.Kind() != tt.want {
+				t.Errorf("Value.Kind() = %v, want %v", v.Kind(), tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			b, err := json.Marshal(v)
+			if err != nil || string(b) != tt.data {
+				t.Errorf("Value.MarshalJSON() = %s, %v, want %s", b, err, tt.data)
+			}
+		})
+	}
+}
+
+func TestValueBytesJSON(t *testing.T) {
+	v := MustValue([]byte("hello"))
+	if s, err := v.AsString(); err != nil || s.String() != "hello" {
+		t.Errorf("Value.AsString() = %v, %v, want hello", s, err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil || string(b) != `"hello"` {
+		t.Errorf("Value.MarshalJSON() = %s, %v, want \"hello\"", b, err)
+	}
+	var w Value
+	if err := w.UnmarshalJSON(nil); err != nil || w.Valid() {
+		t.Errorf("Value.UnmarshalJSON(empty) = %v, %v, want invalid and nil error", w, err)
+	}
+}
+
+func TestValueAs(t *testing.T) {
+	v := MustValue("3")
+	i, err := v.AsInt()
+	if err != nil || i.Int64() != 3 {
+		t.Errorf("Value.AsInt() = %v, %v, want 3", i, err)
+	}
+	f, err := v.AsFloat()
+	if err != nil || f.Float64() != 3 {
+		t.Errorf("Value.AsFloat() = %v, %v, want 3", f, err)
+	}
+	if _, err = v.AsBool(); err == nil {
+		t.Error("Value.AsBool() expected error")
+	}
+
+	v = MustValue([]byte("true"))
+	b, err := v.AsBool()
+	if err != nil || !b.Bool() {
+		t.Errorf("Value.AsBool() = %v, %v, want true", b, err)
+	}
+
+	v = MustValue(2.5)
+	s, err := v.AsString()
+	if err != nil || s.String() != "2.5" {
+		t.Errorf("Value.AsString() = %v, %v, want 2.5", s, err)
+	}
+
+	ts := time.Date(2020, 7, 24, 20, 0, 0, 0, time.UTC)
+	v = MustValue(ts)
+	tm, err := v.AsTime()
+	if err != nil || !tm.Time().Equal(ts) {
+		t.Errorf("Value.AsTime() = %v, %v, want %v", tm, err, ts)
+	}
+	if v.String() != "2020-07-24T20:00:00Z" {
+		t.Errorf("Value.String() = %v", v.String())
+	}
+
+	i, err = Value{}.AsInt()
+	if err != nil || i.Valid() {
+		t.Errorf("Value.AsInt() of invalid value = %v, %v, want invalid", i, err)
+	}
+}
+
+func TestValueEqual(t *testing.T) {
+	if !MustValue(int8(1)).Equal(MustValue(int64(1))) {
+		t.Error("Value.Equal() should compare normalized values")
+	}
+	if MustValue(1).Equal(MustValue("1")) {
+		t.Error("Value.Equal() of different kinds = true, want false")
+	}
+	if !MustValue([]byte("a")).Equal(MustValue([]byte("a"))) {
+		t.Error("Value.Equal() of bytes = false, want true")
+	}
+	if !(Value{}).Equal(Value{}) || (Value{}).Equal(MustValue(0)) {
+		t.Error("Value.Equal() of invalid values is wrong")
+	}
+}
+
+func TestKindString(t *testing.T) {
+	if KindFloat.String() != "float" || Kind(99).String() != "Kind(99)" {
+		t.Errorf("Kind.String() = %v, %v", KindFloat.String(), Kind(99).String())
+	}
+}
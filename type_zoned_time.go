@@ -0,0 +1,228 @@
+package generic
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// ZonedTime is generic time type structure keeping the IANA time zone of the value.
+// It is formatted as RFC 3339 followed by the zone name in brackets, as in "2024-01-02T03:04:05+09:00[Asia/Tokyo]".
+type ZonedTime struct {
+	ValidFlag
+	zoned zonedTime
+}
+
+type zonedTime struct {
+	time time.Time
+	// zone is the IANA name of the location of time, or empty if the location has none
+	zone string
+}
+
+// MarshalZonedTime return generic.ZonedTime converting of request data
+func MarshalZonedTime(x interface{}) (ZonedTime, error) {
+	v := ZonedTime{}
+	err := v.Scan(x)
+	return v, err
+}
+
+// MustZonedTime return generic.ZonedTime converting of request data
+func MustZonedTime(x interface{}) ZonedTime {
+	v, err := MarshalZonedTime(x)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Value implements the driver Valuer interface.
+// It returns the instant as time.Time, so the zone should be stored in another column and restored with ScanColumns.
+func (v ZonedTime) Value() (driver.Value, error) {
+	if !v.Valid() {
+		return nil, nil
+	}
+	return v.zoned.time, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// x may be time.Time, a string with an optional bracketed zone name, or []interface{}{timestamp, zone}.
+func (v *ZonedTime) Scan(x interface{}) (err error) {
+	v.zoned, v.ValidFlag, err = asZonedTime(x)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// ScanColumns sets the value from a timestamp column and an IANA zone name column.
+// A NULL timestamp makes the value invalid, and a NULL zone keeps the location of the timestamp.
+func (v *ZonedTime) ScanColumns(timestamp, zone interface{}) (err error) {
+	v.zoned, v.ValidFlag, err = asZonedTimeColumns(timestamp, zone)
+	if err != nil {
+		v.ValidFlag = false
+		return err
+	}
+	return
+}
+
+// Weak returns time.Time, but if ZonedTime.ValidFlag is false, returns nil.
+func (v ZonedTime) Weak() interface{} {
+	i, _ := v.Value()
+	return i
+}
+
+// Set sets a specified value.
+func (v *ZonedTime) Set(x interface{}) (err error) {
+	return v.Scan(x)
+}
+
+// Time returns value as time.Time in its zone
+func (v ZonedTime) Time() time.Time {
+	if !v.Valid() {
+		return time.Unix(0, 0)
+	}
+	return v.zoned.time
+}
+
+// Zone returns the IANA zone name, or an empty string if the location has no IANA name.
+func (v ZonedTime) Zone() string {
+	if !v.Valid() {
+		return ""
+	}
+	return v.zoned.zone
+}
+
+// In returns the same instant in loc.
+// If ZonedTime.ValidFlag is false, In returns an invalid ZonedTime.
+func (v ZonedTime) In(loc *time.Location) ZonedTime {
+	if !v.Valid() {
+		return ZonedTime{}
+	}
+	t := v.zoned.time.In(loc)
+	return ZonedTime{ValidFlag: true, zoned: zonedTime{time: t, zone: zoneName(loc)}}
+}
+
+// InZone returns the same instant in the zone with the IANA name.
+// If ZonedTime.ValidFlag is false, InZone returns an invalid ZonedTime.
+func (v ZonedTime) InZone(name string) (ZonedTime, error) {
+	loc, err := loadZone(name)
+	if err != nil {
+		return ZonedTime{}, err
+	}
+	return v.In(loc), nil
+}
+
+// String implements the Stringer interface.
+func (v ZonedTime) String() string {
+	if !v.Valid() {
+		return ""
+	}
+	s := v.zoned.time.Format(time.RFC3339Nano)
+	if v.zoned.zone != "" {
+		s += "[" + v.zoned.zone + "]"
+	}
+	return s
+}
+
+// Equal reports whether v and x are the same instant in the same zone.
+// Two invalid values are equal, and an invalid value never equals a valid one.
+func (v ZonedTime) Equal(x ZonedTime) bool {
+	if !v.Valid() || !x.Valid() {
+		return v.Valid() == x.Valid()
+	}
+	return v.zoned.time.Equal(x.zoned.time) && v.zoned.zone == x.zoned.zone
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to or greater than x.
+// Only the instants are compared.
+// o decides whether invalid values are ordered before or after valid values.
+func (v ZonedTime) Compare(x ZonedTime, o NullOrder) int {
+	if r, done := compareValidity(v.Valid(), x.Valid(), o); done {
+		return r
+	}
+	switch {
+	case v.zoned.time.Before(x.zoned.time):
+		return -1
+	case v.zoned.time.After(x.zoned.time):
+		return 1
+	}
+	return 0
+}
+
+// Since returns the time elapsed since v by DefaultClock, or 0 if v is invalid.
+func (v ZonedTime) Since() time.Duration {
+	return sinceClock(v.zoned.time, v.ValidFlag)
+}
+
+// IsPast reports whether v is valid and before the current time of DefaultClock.
+func (v ZonedTime) IsPast() bool {
+	return isPast(v.zoned.time, v.ValidFlag)
+}
+
+// IsFuture reports whether v is valid and after the current time of DefaultClock.
+func (v ZonedTime) IsFuture() bool {
+	return isFuture(v.zoned.time, v.ValidFlag)
+}
+
+// ValidateWithin returns ErrTimeOutOfRange unless v is within past and future of DefaultClock,
+// and ErrInvalidGenericValue if v is invalid, in the same way as Time.ValidateWithin.
+func (v ZonedTime) ValidateWithin(past, future time.Duration) error {
+	return validateWithin(v.zoned.time, v.ValidFlag, past, future)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v ZonedTime) MarshalJSON() ([]byte, error) {
+	if !v.Valid() {
+		return nullBytes, nil
+	}
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *ZonedTime) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var in interface{}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	return v.Scan(in)
+}
+
+// zoneName returns the IANA name of loc, or an empty string if loc has none.
+func zoneName(loc *time.Location) string {
+	switch loc {
+	case nil, time.Local:
+		return ""
+	case time.UTC:
+		return "UTC"
+	}
+	name := loc.String()
+	if _, err := loadZone(name); err != nil {
+		return ""
+	}
+	return name
+}
+
+// zoneCache keeps the locations resolved by loadZone, keyed by IANA name.
+var zoneCache sync.Map
+
+// loadZone returns the location with the IANA name, caching it for later calls.
+// An empty name and "Local" are rejected, because they do not name a zone.
+func loadZone(name string) (*time.Location, error) {
+	if loc, ok := zoneCache.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	if name == "" || name == "Local" {
+		return nil, ErrInvalidGenericValue{Value: name}
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidGenericValue{Value: name}
+	}
+	zoneCache.Store(name, loc)
+	return loc, nil
+}
@@ -0,0 +1,133 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestMarshalZonedTime(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	instant := time.Date(2024, 1, 1, 18, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name     string
+		args     interface{}
+		want     string
+		wantZone string
+		wantErr  bool
+	}{
+		{name: "time in zone", args: instant.In(tokyo), want: "2024-01-02T03:04:05+09:00[Asia/Tokyo]", wantZone: "Asia/Tokyo"},
+		{name: "utc", args: instant, want: "2024-01-01T18:04:05Z[UTC]", wantZone: "UTC"},
+		{name: "fixed zone", args: instant.In(time.FixedZone("", 3600)), want: "2024-01-01T19:04:05+01:00"},
+		{name: "string with zone", args: "2024-01-01T18:04:05Z[Asia/Tokyo]", want: "2024-01-02T03:04:05+09:00[Asia/Tokyo]", wantZone: "Asia/Tokyo"},
+		{name: "critical zone and annotation", args: []byte("2024-01-02T03:04:05+09:00[!Asia/Tokyo][u-ca=japanese]"), want: "2024-01-02T03:04:05+09:00[Asia/Tokyo]", wantZone: "Asia/Tokyo"},
+		{name: "wall clock in zone", args: "2024-01-02 03:04:05[Asia/Tokyo]", want: "2024-01-02T03:04:05+09:00[Asia/Tokyo]", wantZone: "Asia/Tokyo"},
+		{name: "pair", args: []interface{}{instant, "Asia/Tokyo"}, want: "2024-01-02T03:04:05+09:00[Asia/Tokyo]", wantZone: "Asia/Tokyo"},
+		{name: "pair with null zone", args: []interface{}{instant, nil}, want: "2024-01-01T18:04:05Z[UTC]", wantZone: "UTC"},
+		{name: "string with null zone", args: []interface{}{"2024-01-02T03:04:05+09:00", nil}, want: "2024-01-02T03:04:05+09:00"},
+		{name: "string without zone", args: "2024-01-02T03:04:05Z", want: "2024-01-02T03:04:05Z[UTC]", wantZone: "UTC"},
+		{name: "string with offset only", args: "2024-01-02T03:04:05+09:00", want: "2024-01-02T03:04:05+09:00"},
+		{name: "invalid string without zone", args: "yesterday", wantErr: true},
+		{name: "null timestamp", args: []interface{}{nil, "Asia/Tokyo"}},
+		{name: "nil", args: nil},
+		{name: "unknown zone", args: "2024-01-02T03:04:05Z[Mars/Olympus]", wantErr: true},
+		{name: "local is not a zone name", args: []interface{}{instant, "Local"}, wantErr: true},
+		{name: "int", args: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalZonedTime(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalZonedTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ZonedTime.String() = %v, want %v", got.String(), tt.want)
+			}
+			if got.Zone() != tt.wantZone {
+				t.Errorf("ZonedTime.Zone() = %v, want %v", got.Zone(), tt.wantZone)
+			}
+		})
+	}
+}
+
+func TestZonedTimeJSON(t *testing.T) {
+	var v ZonedTime
+	if err := json.Unmarshal([]byte(`"2024-07-01T12:00:00-04:00[America/New_York]"`), &v); err != nil {
+		t.Fatalf("ZonedTime.UnmarshalJSON() error = %v", err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil || string(b) != `"2024-07-01T12:00:00-04:00[America/New_York]"` {
+		t.Errorf("ZonedTime.MarshalJSON() = %s, %v", b, err)
+	}
+	if err := json.Unmarshal([]byte(`"2024-07-01T12:00:00-04:00"`), &v); err != nil {
+		t.Fatalf("ZonedTime.UnmarshalJSON() error = %v", err)
+	}
+	b, err = json.Marshal(v)
+	if err != nil || string(b) != `"2024-07-01T12:00:00-04:00"` || v.Zone() != "" {
+		t.Errorf("ZonedTime.MarshalJSON() = %s, %v, zone %q", b, err, v.Zone())
+	}
+	var w ZonedTime
+	if err = json.Unmarshal(b, &w); err != nil || !w.Equal(v) {
+		t.Errorf("ZonedTime.UnmarshalJSON() = %v, %v, want %v", w, err, v)
+	}
+	if err := json.Unmarshal([]byte(`null`), &v); err != nil || v.Valid() {
+		t.Errorf("ZonedTime.UnmarshalJSON(null) = %v, %v", v, err)
+	}
+	b, _ = json.Marshal(v)
+	if string(b) != "null" {
+		t.Errorf("ZonedTime.MarshalJSON() = %s, want null", b)
+	}
+}
+
+func TestZonedTimeIn(t *testing.T) {
+	v := MustZonedTime("2024-01-02T03:04:05+09:00[Asia/Tokyo]")
+	w, err := v.InZone("Europe/London")
+	if err != nil {
+		t.Fatalf("ZonedTime.InZone() error = %v", err)
+	}
+	if w.String() != "2024-01-01T18:04:05Z[Europe/London]" {
+		t.Errorf("ZonedTime.InZone() = %v", w)
+	}
+	if w.Equal(v) || w.Compare(v, NullsFirst) != 0 {
+		t.Error("ZonedTime.Equal() should compare zones while Compare should not")
+	}
+	if u := v.In(time.UTC); u.Zone() != "UTC" || !u.Time().Equal(v.Time()) {
+		t.Errorf("ZonedTime.In() = %v", u)
+	}
+	if _, err := v.InZone("Nowhere"); err == nil {
+		t.Error("ZonedTime.InZone() expected error")
+	}
+	if (ZonedTime{}).In(time.UTC).Valid() {
+		t.Error("ZonedTime.In() of invalid value should be invalid")
+	}
+}
+
+func TestZonedTimeScanColumns(t *testing.T) {
+	var v ZonedTime
+	if err := v.ScanColumns(time.Date(2024, 1, 1, 18, 4, 5, 0, time.UTC), []byte("Asia/Tokyo")); err != nil {
+		t.Fatalf("ZonedTime.ScanColumns() error = %v", err)
+	}
+	if v.String() != "2024-01-02T03:04:05+09:00[Asia/Tokyo]" {
+		t.Errorf("ZonedTime.ScanColumns() = %v", v)
+	}
+	got, _ := v.Value()
+	if tm, ok := got.(time.Time); !ok || !tm.Equal(v.Time()) {
+		t.Errorf("ZonedTime.Value() = %v", got)
+	}
+}
+
+func TestLoadZone(t *testing.T) {
+	a, err := loadZone("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("loadZone() error = %v", err)
+	}
+	if b, _ := loadZone("Asia/Tokyo"); a != b {
+		t.Error("loadZone() should return the cached location")
+	}
+	for _, name := range []string{"", "Local", "Nowhere"} {
+		if _, err := loadZone(name); err == nil {
+			t.Errorf("loadZone(%q) expected error", name)
+		}
+	}
+}